@@ -6,8 +6,9 @@ import (
 )
 
 type GitLabConfig struct {
-	BaseUrl *string `cty:"baseurl"`
-	Token   *string `cty:"token"`
+	BaseUrl           *string `cty:"baseurl"`
+	Token             *string `cty:"token"`
+	VariableValueMode *string `cty:"variable_value_mode"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -17,6 +18,9 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"token": {
 		Type: schema.TypeString,
 	},
+	"variable_value_mode": {
+		Type: schema.TypeString,
+	},
 }
 
 func ConfigInstance() interface{} {
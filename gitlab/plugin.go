@@ -21,45 +21,87 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 				"404",
 			})},
 		TableMap: map[string]*plugin.Table{
-			"gitlab_application":                tableApplication(),
-			"gitlab_branch":                     tableBranch(),
-			"gitlab_commit":                     tableCommit(),
-			"gitlab_epic":                       tableEpic(),
-			"gitlab_group":                      tableGroup(),
-			"gitlab_group_access_request":       tableGroupAccessRequest(),
-			"gitlab_group_hook":                 tableGroupHook(),
-			"gitlab_group_iteration":            tableGroupIteration(),
-			"gitlab_group_member":               tableGroupMember(),
-			"gitlab_group_project":              tableGroupProject(),
-			"gitlab_group_push_rule":            tableGroupPushRule(),
-			"gitlab_group_subgroup":             tableGroupSubgroup(),
-			"gitlab_group_variable":             tableGroupVariable(),
-			"gitlab_instance_variable":          tableInstanceVariable(),
-			"gitlab_issue":                      tableIssue(),
-			"gitlab_merge_request":              tableMergeRequest(),
-			"gitlab_merge_request_change":       tableMergeRequestChange(),
-			"gitlab_my_event":                   tableMyEvents(),
-			"gitlab_my_issue":                   tableMyIssue(),
-			"gitlab_my_project":                 tableMyProject(),
-			"gitlab_project":                    tableProject(),
-			"gitlab_project_access_request":     tableProjectAccessRequest(),
-			"gitlab_project_container_registry": tableProjectContainerRegistry(),
-			"gitlab_project_deployment":         tableProjectDeployment(),
-			"gitlab_project_iteration":          tableProjectIteration(),
-			"gitlab_project_job":                tableProjectJob(),
-			"gitlab_project_member":             tableProjectMember(),
-			"gitlab_project_pages_domain":       tableProjectPagesDomain(),
-			"gitlab_project_pipeline":           tableProjectPipeline(),
-			"gitlab_project_pipeline_detail":    tableProjectPipelineDetail(),
-			"gitlab_project_protected_branch":   tableProjectProtectedBranch(),
-			"gitlab_project_repository":         tableProjectRepository(),
-			"gitlab_project_repository_file":    tableProjectRepositoryFile(),
-			"gitlab_project_variable":           tableProjectVariable(),
-			"gitlab_setting":                    tableSetting(),
-			"gitlab_snippet":                    tableSnippet(),
-			"gitlab_user":                       tableUser(),
-			"gitlab_user_event":                 tableUserEvents(),
-			"gitlab_version":                    tableVersion(),
+			"gitlab_application":                    tableApplication(),
+			"gitlab_audit_event":                    tableAuditEvent(),
+			"gitlab_branch":                         tableBranch(),
+			"gitlab_commit":                         tableCommit(),
+			"gitlab_current_token":                  tableCurrentToken(),
+			"gitlab_epic":                           tableEpic(),
+			"gitlab_group":                          tableGroup(),
+			"gitlab_group_access_request":           tableGroupAccessRequest(),
+			"gitlab_group_access_token":             tableGroupAccessToken(),
+			"gitlab_group_audit_event":              tableGroupAuditEvent(),
+			"gitlab_group_descendant":               tableGroupDescendant(),
+			"gitlab_group_hook":                     tableGroupHook(),
+			"gitlab_group_iteration":                tableGroupIteration(),
+			"gitlab_group_member":                   tableGroupMember(),
+			"gitlab_group_project":                  tableGroupProject(),
+			"gitlab_group_push_rule":                tableGroupPushRule(),
+			"gitlab_group_shared_group":             tableGroupSharedGroup(),
+			"gitlab_group_subgroup":                 tableGroupSubgroup(),
+			"gitlab_group_variable":                 tableGroupVariable(),
+			"gitlab_instance_feature":               tableInstanceFeature(),
+			"gitlab_instance_variable":              tableInstanceVariable(),
+			"gitlab_issue":                          tableIssue(),
+			"gitlab_issue_closed_by":                tableIssueClosedBy(),
+			"gitlab_issue_label_event":              tableIssueLabelEvent(),
+			"gitlab_issue_link":                     tableIssueLink(),
+			"gitlab_issue_milestone_event":          tableIssueMilestoneEvent(),
+			"gitlab_issue_related_merge_request":    tableIssueRelatedMergeRequest(),
+			"gitlab_issue_state_event":              tableIssueStateEvent(),
+			"gitlab_issue_timelog":                  tableIssueTimelog(),
+			"gitlab_merge_request":                  tableMergeRequest(),
+			"gitlab_merge_request_change":           tableMergeRequestChange(),
+			"gitlab_merge_request_commit":           tableMergeRequestCommit(),
+			"gitlab_merge_request_diff_line":        tableMergeRequestDiffLine(),
+			"gitlab_merge_request_pipeline":         tableMergeRequestPipeline(),
+			"gitlab_merge_request_version":          tableMergeRequestVersion(),
+			"gitlab_my_email":                       tableMyEmail(),
+			"gitlab_my_event":                       tableMyEvents(),
+			"gitlab_my_gpg_key":                     tableMyGPGKey(),
+			"gitlab_my_issue":                       tableMyIssue(),
+			"gitlab_my_project":                     tableMyProject(),
+			"gitlab_my_ssh_key":                     tableMySSHKey(),
+			"gitlab_namespace":                      tableNamespace(),
+			"gitlab_personal_access_token":          tablePersonalAccessToken(),
+			"gitlab_project":                        tableProject(),
+			"gitlab_project_access_request":         tableProjectAccessRequest(),
+			"gitlab_project_access_token":           tableProjectAccessToken(),
+			"gitlab_project_audit_event":            tableProjectAuditEvent(),
+			"gitlab_project_ci_lint":                tableProjectCILint(),
+			"gitlab_project_container_registry":     tableProjectContainerRegistry(),
+			"gitlab_project_contributor":            tableProjectContributor(),
+			"gitlab_project_deployment":             tableProjectDeployment(),
+			"gitlab_project_event":                  tableProjectEvent(),
+			"gitlab_project_feature_flag":           tableProjectFeatureFlag(),
+			"gitlab_project_feature_flag_user_list": tableProjectFeatureFlagUserList(),
+			"gitlab_project_iteration":              tableProjectIteration(),
+			"gitlab_project_job":                    tableProjectJob(),
+			"gitlab_project_job_log_line":           tableProjectJobLogLine(),
+			"gitlab_project_job_token_scope":        tableProjectJobTokenScope(),
+			"gitlab_project_member":                 tableProjectMember(),
+			"gitlab_project_pages_domain":           tableProjectPagesDomain(),
+			"gitlab_project_pipeline":               tableProjectPipeline(),
+			"gitlab_project_pipeline_detail":        tableProjectPipelineDetail(),
+			"gitlab_project_protected_branch":       tableProjectProtectedBranch(),
+			"gitlab_project_push_rule":              tableProjectPushRule(),
+			"gitlab_project_repository":             tableProjectRepository(),
+			"gitlab_project_repository_compare":     tableProjectRepositoryCompare(),
+			"gitlab_project_repository_file":        tableProjectRepositoryFile(),
+			"gitlab_project_repository_file_blame":  tableProjectRepositoryFileBlame(),
+			"gitlab_project_secure_file":            tableProjectSecureFile(),
+			"gitlab_project_shared_group":           tableProjectSharedGroup(),
+			"gitlab_project_variable":               tableProjectVariable(),
+			"gitlab_setting":                        tableSetting(),
+			"gitlab_snippet":                        tableSnippet(),
+			"gitlab_topic":                          tableTopic(),
+			"gitlab_user":                           tableUser(),
+			"gitlab_user_email":                     tableUserEmail(),
+			"gitlab_user_event":                     tableUserEvents(),
+			"gitlab_user_gpg_key":                   tableUserGPGKey(),
+			"gitlab_user_membership":                tableUserMembership(),
+			"gitlab_user_ssh_key":                   tableUserSSHKey(),
+			"gitlab_version":                        tableVersion(),
 		},
 	}
 
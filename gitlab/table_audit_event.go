@@ -0,0 +1,256 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// listInstanceAuditEventsOptions extends the SDK options with the entity filters only available at the instance level.
+type listInstanceAuditEventsOptions struct {
+	api.ListAuditEventsOptions
+	EntityType *string `url:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityID   *int    `url:"entity_id,omitempty" json:"entity_id,omitempty"`
+}
+
+func tableAuditEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_audit_event",
+		Description: "Obtain information about instance level audit events within the GitLab instance (requires admin).",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "created_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+				{
+					Name:      "entity_type",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "entity_id",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listAuditEvents,
+		},
+		Columns: auditEventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listAuditEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listAuditEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAuditEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	opt := &listInstanceAuditEventsOptions{ListAuditEventsOptions: api.ListAuditEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}}
+	opt.CreatedAfter, opt.CreatedBefore = timeRangeQualifiers(d, "created_at")
+
+	if q["entity_type"] != nil {
+		entityType := q["entity_type"].GetStringValue()
+		opt.EntityType = &entityType
+		plugin.Logger(ctx).Debug("listAuditEvents", "filter[entity_type]", entityType)
+	}
+
+	if q["entity_id"] != nil {
+		entityId := int(q["entity_id"].GetInt64Value())
+		opt.EntityID = &entityId
+		plugin.Logger(ctx).Debug("listAuditEvents", "filter[entity_id]", entityId)
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listAuditEvents", "page", opt.Page, "perPage", opt.PerPage)
+		req, err := conn.NewRequest(http.MethodGet, "audit_events", opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listAuditEvents", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to create request for audit events\n%v", err)
+		}
+
+		var events []*api.AuditEvent
+		resp, err := conn.Do(req, &events)
+		if err != nil {
+			plugin.Logger(ctx).Error("listAuditEvents", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain audit events\n%v", err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listAuditEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listAuditEvents", "completed successfully")
+	return nil, nil
+}
+
+// Transform Functions
+func auditEventTargetIdTransform(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	if input.Value == nil {
+		return nil, nil
+	}
+
+	// The API returns the target id as either a number or a string depending upon the type of the target.
+	switch v := input.Value.(type) {
+	case float64:
+		return fmt.Sprintf("%.0f", v), nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return v, nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// Column Function
+func auditEventColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the audit event.",
+		},
+		{
+			Name:        "author_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user who triggered the event - link to `gitlab_user.id`.",
+		},
+		{
+			Name:        "author_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the user who triggered the event.",
+			Transform:   transform.FromField("Details.AuthorName").NullIfZero(),
+		},
+		{
+			Name:        "entity_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the entity the event relates to.",
+		},
+		{
+			Name:        "entity_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of the entity the event relates to (User, Group, Project, etc).",
+		},
+		{
+			Name:        "entity_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full path of the entity the event relates to.",
+			Transform:   transform.FromField("Details.EntityPath").NullIfZero(),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the event occurred.",
+		},
+		{
+			Name:        "target_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the target of the event.",
+			Transform:   transform.FromField("Details.TargetID").Transform(auditEventTargetIdTransform),
+		},
+		{
+			Name:        "target_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of the target of the event.",
+			Transform:   transform.FromField("Details.TargetType").NullIfZero(),
+		},
+		{
+			Name:        "target_details",
+			Type:        proto.ColumnType_STRING,
+			Description: "Details of the target of the event.",
+			Transform:   transform.FromField("Details.TargetDetails").NullIfZero(),
+		},
+		{
+			Name:        "ip_address",
+			Type:        proto.ColumnType_IPADDR,
+			Description: "The IP address from which the event was triggered.",
+			Transform:   transform.FromField("Details.IPAddress").NullIfZero(),
+		},
+		{
+			Name:        "custom_message",
+			Type:        proto.ColumnType_STRING,
+			Description: "The message describing the event.",
+			Transform:   transform.FromField("Details.CustomMessage").NullIfZero(),
+		},
+		{
+			Name:        "change",
+			Type:        proto.ColumnType_STRING,
+			Description: "The attribute which was changed.",
+			Transform:   transform.FromField("Details.Change").NullIfZero(),
+		},
+		{
+			Name:        "change_from",
+			Type:        proto.ColumnType_STRING,
+			Description: "The value of the attribute prior to the change.",
+			Transform:   transform.FromField("Details.From").NullIfZero(),
+		},
+		{
+			Name:        "change_to",
+			Type:        proto.ColumnType_STRING,
+			Description: "The value of the attribute after the change.",
+			Transform:   transform.FromField("Details.To").NullIfZero(),
+		},
+		{
+			Name:        "add",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of object which was added.",
+			Transform:   transform.FromField("Details.Add").NullIfZero(),
+		},
+		{
+			Name:        "remove",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of object which was removed.",
+			Transform:   transform.FromField("Details.Remove").NullIfZero(),
+		},
+		{
+			Name:        "role",
+			Type:        proto.ColumnType_STRING,
+			Description: "The role or access level applied as part of the event.",
+			Transform:   transform.FromField("Details.As").NullIfZero(),
+		},
+		{
+			Name:        "auth_method",
+			Type:        proto.ColumnType_STRING,
+			Description: "The authentication method used (for sign-in events).",
+			Transform:   transform.FromField("Details.With").NullIfZero(),
+		},
+		{
+			Name:        "failed_login",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of failed login (for failed sign-in events).",
+			Transform:   transform.FromField("Details.FailedLogin").NullIfZero(),
+		},
+		{
+			Name:        "details",
+			Type:        proto.ColumnType_JSON,
+			Description: "The full details of the event.",
+		},
+	}
+}
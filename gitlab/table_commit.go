@@ -3,20 +3,67 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
-	"strings"
 )
 
+// CommitSignature is the signature of a commit, the SDK only supports GPG signatures so this also covers SSH and X.509 signatures.
+type CommitSignature struct {
+	SignatureType      string                 `json:"signature_type"`
+	VerificationStatus string                 `json:"verification_status"`
+	GPGKeyID           int                    `json:"gpg_key_id"`
+	GPGKeyPrimaryKeyID string                 `json:"gpg_key_primary_keyid"`
+	GPGKeyUserName     string                 `json:"gpg_key_user_name"`
+	GPGKeyUserEmail    string                 `json:"gpg_key_user_email"`
+	GPGKeySubkeyID     int                    `json:"gpg_key_subkey_id"`
+	Key                map[string]interface{} `json:"key"`
+	X509Certificate    map[string]interface{} `json:"x509_certificate"`
+	CommitSource       string                 `json:"commit_source"`
+}
+
 func tableCommit() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_commit",
 		Description: "Obtain information about commits for a specific project within the GitLab instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listCommits,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "ref_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "path",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "author",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "first_parent",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "committed_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+			Hydrate: listCommits,
 		},
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
@@ -36,15 +83,16 @@ func listCommits(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	}
 
 	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
-	bTrue := true
-	opt := &api.ListCommitsOptions{All: &bTrue, WithStats: &bTrue, ListOptions: api.ListOptions{
-		Page:    1,
-		PerPage: 50,
-	}}
+	opt := buildCommitsOptions(d)
+	var options []api.RequestOptionFunc
+	if d.EqualsQuals["author"] != nil {
+		// The author filter isn't supported by the SDK options, so it is added to the request directly.
+		options = append(options, withQueryParam("author", d.EqualsQuals["author"].GetStringValue()))
+	}
 
 	for {
 		plugin.Logger(ctx).Debug("listCommits", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		commits, resp, err := conn.Commits.ListCommits(projectId, opt)
+		commits, resp, err := conn.Commits.ListCommits(projectId, opt, options...)
 		if err != nil {
 			// Handle error of project id not being valid.
 			if strings.Contains(err.Error(), "404") {
@@ -106,6 +154,149 @@ func getCommit(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	return commit, nil
 }
 
+func getCommitSignature(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	commit := h.Item.(*api.Commit)
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitSignature", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	u := fmt.Sprintf("projects/%d/repository/commits/%s/signature", commit.ProjectID, url.PathEscape(commit.ID))
+	req, err := conn.NewRequest(http.MethodGet, u, nil, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitSignature", "projectId", commit.ProjectID, "commitId", commit.ID, "error", err)
+		return nil, fmt.Errorf("unable to obtain signature of commit %s for project_id %d\n%v", commit.ID, commit.ProjectID, err)
+	}
+
+	var signature CommitSignature
+	_, err = conn.Do(req, &signature)
+	if err != nil {
+		// Handle the commit not being signed.
+		if strings.Contains(err.Error(), "404") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("getCommitSignature", "projectId", commit.ProjectID, "commitId", commit.ID, "error", err)
+		return nil, fmt.Errorf("unable to obtain signature of commit %s for project_id %d\n%v", commit.ID, commit.ProjectID, err)
+	}
+
+	return &signature, nil
+}
+
+func getCommitStatuses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	commit := h.Item.(*api.Commit)
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitStatuses", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	opt := &api.GetCommitStatusesOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	var statuses []*api.CommitStatus
+	for {
+		s, resp, err := conn.Commits.GetCommitStatuses(commit.ProjectID, commit.ID, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("getCommitStatuses", "projectId", commit.ProjectID, "commitId", commit.ID, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain statuses of commit %s for project_id %d\n%v", commit.ID, commit.ProjectID, err)
+		}
+
+		statuses = append(statuses, s...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return statuses, nil
+}
+
+func getCommitRefs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	commit := h.Item.(*api.Commit)
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitRefs", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	opt := &api.GetCommitRefsOptions{Type: api.String("all"), ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	var refs []*api.CommitRef
+	for {
+		r, resp, err := conn.Commits.GetCommitRefs(commit.ProjectID, commit.ID, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("getCommitRefs", "projectId", commit.ProjectID, "commitId", commit.ID, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain refs of commit %s for project_id %d\n%v", commit.ID, commit.ProjectID, err)
+		}
+
+		refs = append(refs, r...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return refs, nil
+}
+
+func getCommitMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	commit := h.Item.(*api.Commit)
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitMergeRequests", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	mergeRequests, _, err := conn.Commits.ListMergeRequestsByCommit(commit.ProjectID, commit.ID)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommitMergeRequests", "projectId", commit.ProjectID, "commitId", commit.ID, "error", err)
+		return nil, fmt.Errorf("unable to obtain merge requests of commit %s for project_id %d\n%v", commit.ID, commit.ProjectID, err)
+	}
+
+	return mergeRequests, nil
+}
+
+// Assist Functions
+func buildCommitsOptions(d *plugin.QueryData) *api.ListCommitsOptions {
+	q := d.EqualsQuals
+	opt := &api.ListCommitsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	opt.Since, opt.Until = timeRangeQualifiers(d, "committed_date")
+
+	// Obtaining stats is expensive on large repositories, so only request them when needed.
+	if isColumnRequested(d, "commit_additions", "commit_deletions", "commit_total_changes") {
+		opt.WithStats = api.Bool(true)
+	}
+
+	if q["path"] != nil {
+		opt.Path = api.String(q["path"].GetStringValue())
+	}
+
+	if q["first_parent"] != nil {
+		opt.FirstParent = api.Bool(q["first_parent"].GetBoolValue())
+	}
+
+	// Without a ref the commits of every ref are returned, unless only the first parents (of the default branch) are requested.
+	if q["ref_name"] != nil {
+		opt.RefName = api.String(q["ref_name"].GetStringValue())
+	} else if opt.FirstParent == nil || !*opt.FirstParent {
+		opt.All = api.Bool(true)
+	}
+
+	return opt
+}
+
 // Column Function
 func commitColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -254,5 +445,102 @@ func commitColumns() []*plugin.Column {
 			Description: "Timestamp indicating when the last pipeline instance was updated.",
 			Transform:   transform.FromField("LastPipeline.UpdatedAt"),
 		},
+		// Signature
+		{
+			Name:        "signature_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of signature of the commit (PGP, SSH or X509), null if the commit isn't signed.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("SignatureType").NullIfZero(),
+		},
+		{
+			Name:        "signature_verification_status",
+			Type:        proto.ColumnType_STRING,
+			Description: "The verification status of the signature of the commit (e.g. verified, unverified or unknown_key).",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("VerificationStatus").NullIfZero(),
+		},
+		{
+			Name:        "signature_gpg_key_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the GPG key the commit was signed with - link to `gitlab_user_gpg_key.id`.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("GPGKeyID").NullIfZero(),
+		},
+		{
+			Name:        "signature_gpg_key_primary_keyid",
+			Type:        proto.ColumnType_STRING,
+			Description: "The primary key ID of the GPG key the commit was signed with.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("GPGKeyPrimaryKeyID").NullIfZero(),
+		},
+		{
+			Name:        "signature_gpg_key_user_email",
+			Type:        proto.ColumnType_STRING,
+			Description: "The email address of the user of the GPG key the commit was signed with.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("GPGKeyUserEmail").NullIfZero(),
+		},
+		{
+			Name:        "signature_ssh_key",
+			Type:        proto.ColumnType_JSON,
+			Description: "The SSH key the commit was signed with.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("Key"),
+		},
+		{
+			Name:        "signature_x509_certificate",
+			Type:        proto.ColumnType_JSON,
+			Description: "The X.509 certificate the commit was signed with, including its issuer.",
+			Hydrate:     getCommitSignature,
+			Transform:   transform.FromField("X509Certificate"),
+		},
+		// Related Resources
+		{
+			Name:        "statuses",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the latest statuses (including external CI statuses) of the commit.",
+			Hydrate:     getCommitStatuses,
+			Transform:   transform.FromValue(),
+		},
+		{
+			Name:        "refs",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the branches and tags containing the commit.",
+			Hydrate:     getCommitRefs,
+			Transform:   transform.FromValue(),
+		},
+		{
+			Name:        "merge_requests",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the merge requests associated with the commit.",
+			Hydrate:     getCommitMergeRequests,
+			Transform:   transform.FromValue(),
+		},
+		// Qualifiers
+		{
+			Name:        "ref_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the branch, tag or revision range the commits were listed from, defaults to all refs.",
+			Transform:   transform.FromQual("ref_name"),
+		},
+		{
+			Name:        "path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The file path the commits were filtered on.",
+			Transform:   transform.FromQual("path"),
+		},
+		{
+			Name:        "author",
+			Type:        proto.ColumnType_STRING,
+			Description: "The author name or email the commits were searched by.",
+			Transform:   transform.FromQual("author"),
+		},
+		{
+			Name:        "first_parent",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if only the first parent commit was followed upon seeing a merge commit.",
+			Transform:   transform.FromQual("first_parent"),
+		},
 	}
 }
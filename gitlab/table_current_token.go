@@ -0,0 +1,40 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableCurrentToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_current_token",
+		Description: "Obtain information about the access token currently used by the plugin.",
+		List: &plugin.ListConfig{
+			Hydrate: listCurrentToken,
+		},
+		Columns: personalAccessTokenColumns(),
+	}
+}
+
+// Hydrate Function
+func listCurrentToken(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listCurrentToken", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCurrentToken", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	token, _, err := conn.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		plugin.Logger(ctx).Error("listCurrentToken", "error", err)
+		return nil, fmt.Errorf("unable to obtain information about the current token\n%v", err)
+	}
+
+	d.StreamListItem(ctx, token)
+
+	plugin.Logger(ctx).Debug("listCurrentToken", "completed successfully")
+	return nil, nil
+}
@@ -0,0 +1,76 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableGroupAccessToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_group_access_token",
+		Description: "Obtain information about access tokens for a specific group within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("group_id"),
+			Hydrate:    listGroupAccessTokens,
+		},
+		Columns: groupAccessTokenColumns(),
+	}
+}
+
+// Hydrate Functions
+func listGroupAccessTokens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupAccessTokens", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupAccessTokens", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	opt := &api.ListGroupAccessTokensOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listGroupAccessTokens", "groupId", groupId, "page", opt.Page, "perPage", opt.PerPage)
+		tokens, resp, err := conn.GroupAccessTokens.ListGroupAccessTokens(groupId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listGroupAccessTokens", "groupId", groupId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain access tokens for group_id %d\n%v", groupId, err)
+		}
+
+		for _, token := range tokens {
+			d.StreamListItem(ctx, token)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listGroupAccessTokens", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listGroupAccessTokens", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func groupAccessTokenColumns() []*plugin.Column {
+	return append(accessTokenColumns(), &plugin.Column{
+		Name:        "group_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the group the token belongs to - link to `gitlab_group.id`.",
+		Transform:   transform.FromQual("group_id"),
+	})
+}
@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableGroupAuditEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_group_audit_event",
+		Description: "Obtain information about audit events for a specific group within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "group_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "created_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+			Hydrate: listGroupAuditEvents,
+		},
+		Columns: groupAuditEventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listGroupAuditEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupAuditEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupAuditEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	opt := &api.ListAuditEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+	opt.CreatedAfter, opt.CreatedBefore = timeRangeQualifiers(d, "created_at")
+
+	for {
+		plugin.Logger(ctx).Debug("listGroupAuditEvents", "groupId", groupId, "page", opt.Page, "perPage", opt.PerPage)
+		events, resp, err := conn.AuditEvents.ListGroupAuditEvents(groupId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listGroupAuditEvents", "groupId", groupId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain audit events for group_id %d\n%v", groupId, err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listGroupAuditEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listGroupAuditEvents", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func groupAuditEventColumns() []*plugin.Column {
+	return append(auditEventColumns(), &plugin.Column{
+		Name:        "group_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the group - link to `gitlab_group.id`.",
+		Transform:   transform.FromQual("group_id"),
+	})
+}
@@ -0,0 +1,124 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableGroupDescendant() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_group_descendant",
+		Description: "Obtain information about all descendant groups (subgroups at any depth) of a specific group within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listGroupDescendants,
+			KeyColumns: plugin.SingleColumn("group_id"),
+		},
+		Columns: groupDescendantColumns(),
+	}
+}
+
+// Hydrate Functions
+func listGroupDescendants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupDescendants", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupDescendants", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	stats := true
+	opt := &api.ListDescendantGroupsOptions{Statistics: &stats, ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	for {
+		plugin.Logger(ctx).Debug("listGroupDescendants", "groupId", groupId, "page", opt.Page, "perPage", opt.PerPage)
+		groups, resp, err := conn.Groups.ListDescendantGroups(groupId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listGroupDescendants", "groupId", groupId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain descendant groups for group_id %d\n%v", groupId, err)
+		}
+
+		for _, descendant := range groups {
+			d.StreamListItem(ctx, descendant)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listGroupDescendants", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listGroupDescendants", "completed successfully")
+	return nil, nil
+}
+
+func getGroupDescendantDepth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(*api.Group)
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+
+	ancestorPath, err := getGroupFullPath(ctx, d, groupId)
+	if err != nil {
+		plugin.Logger(ctx).Error("getGroupDescendantDepth", "groupId", groupId, "error", err)
+		return nil, fmt.Errorf("unable to obtain group_id %d\n%v", groupId, err)
+	}
+
+	// The depth is the number of path segments of the descendant below those of the ancestor.
+	return strings.Count(group.FullPath, "/") - strings.Count(ancestorPath, "/"), nil
+}
+
+// Assist Functions
+
+// getGroupFullPath returns the full path of a group, caching the result so each row doesn't require an API call.
+func getGroupFullPath(ctx context.Context, d *plugin.QueryData, groupId int) (string, error) {
+	cacheKey := fmt.Sprintf("group_full_path_%d", groupId)
+	if cachedData, ok := d.ConnectionCache.Get(ctx, cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	group, _, err := conn.Groups.GetGroup(groupId, &api.GetGroupOptions{WithProjects: api.Bool(false)})
+	if err != nil {
+		return "", err
+	}
+
+	d.ConnectionCache.Set(ctx, cacheKey, group.FullPath)
+	return group.FullPath, nil
+}
+
+// Column Function
+func groupDescendantColumns() []*plugin.Column {
+	return append(groupColumns(),
+		&plugin.Column{
+			Name:        "depth",
+			Type:        proto.ColumnType_INT,
+			Description: "The depth of the group below the ancestor group (1 for direct subgroups).",
+			Hydrate:     getGroupDescendantDepth,
+			Transform:   transform.FromValue(),
+		},
+		&plugin.Column{
+			Name:        "group_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the ancestor group - link to `gitlab_group.id`.",
+			Transform:   transform.FromQual("group_id"),
+		},
+	)
+}
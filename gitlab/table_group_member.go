@@ -10,25 +10,43 @@ import (
 )
 
 type GroupMember struct {
-	ID          int
-	Username    string
-	Name        string
-	State       string
-	AvatarUrl   string
-	WebUrl      string
-	ExpiresAt   *api.ISOTime
-	AccessLevel int
-	AccessDesc  string
-	GroupID     int
+	ID               int
+	Username         string
+	Name             string
+	State            string
+	AvatarUrl        string
+	WebUrl           string
+	ExpiresAt        *api.ISOTime
+	AccessLevel      int
+	AccessDesc       string
+	GroupID          int
+	MembershipSource string
+	Inherited        *bool
 }
 
+const (
+	membershipSourceDirect      = "direct"
+	membershipSourceInherited   = "inherited"
+	membershipSourceSharedGroup = "shared_group"
+)
+
 func tableGroupMember() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_group_member",
 		Description: "Obtain information about members of a specific group within the GitLab instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("group_id"),
-			Hydrate:    listGroupMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "group_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "inherited",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listGroupMembers,
 		},
 		Columns: groupMemberColumns(),
 	}
@@ -43,22 +61,41 @@ func listGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	q := d.EqualsQuals
+	groupId := int(q["group_id"].GetInt64Value())
 	opt := &api.ListGroupMembersOptions{ListOptions: api.ListOptions{
 		Page:    1,
 		PerPage: 50,
 	}}
 
+	// Direct members only can be obtained without the members/all endpoint or resolving the source of the membership.
+	directOnly := q["inherited"] != nil && !q["inherited"].GetBoolValue()
+	listMembers := conn.Groups.ListAllGroupMembers
+	if directOnly {
+		listMembers = conn.Groups.ListGroupMembers
+	}
+
+	var sourceOf func(int) string
+	if directOnly {
+		sourceOf = func(int) string { return membershipSourceDirect }
+	} else if q["inherited"] != nil || isColumnRequested(d, "membership_source", "inherited") {
+		sourceOf, err = groupMembershipSources(ctx, conn, groupId)
+		if err != nil {
+			plugin.Logger(ctx).Error("listGroupMembers", "groupId", groupId, "error", err)
+			return nil, fmt.Errorf("unable to obtain membership sources for group_id %d\n%v", groupId, err)
+		}
+	}
+
 	for {
 		plugin.Logger(ctx).Debug("listGroupMembers", "groupId", groupId, "page", opt.Page, "perPage", opt.PerPage)
-		members, resp, err := conn.Groups.ListAllGroupMembers(groupId, opt)
+		members, resp, err := listMembers(groupId, opt)
 		if err != nil {
 			plugin.Logger(ctx).Error("listGroupMembers", "groupId", groupId, "page", opt.Page, "error", err)
 			return nil, fmt.Errorf("unable to obtain members for group_id %d\n%v", groupId, err)
 		}
 
 		for _, member := range members {
-			d.StreamListItem(ctx, &GroupMember{
+			output := &GroupMember{
 				ID:          member.ID,
 				Username:    member.Username,
 				Name:        member.Name,
@@ -69,7 +106,13 @@ func listGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 				AccessLevel: int(member.AccessLevel),
 				AccessDesc:  parseAccessLevel(int(member.AccessLevel)),
 				GroupID:     groupId,
-			})
+			}
+			if sourceOf != nil {
+				output.MembershipSource = sourceOf(member.ID)
+				output.Inherited = api.Bool(output.MembershipSource != membershipSourceDirect)
+			}
+
+			d.StreamListItem(ctx, output)
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				plugin.Logger(ctx).Debug("listGroupMembers", "completed successfully")
@@ -88,6 +131,92 @@ func listGroupMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return nil, nil
 }
 
+// Assist Functions
+
+// groupMembershipSources returns a func resolving how a user obtained their membership of a group - direct members
+// are returned by the members endpoint of the group, inherited members by the members/all endpoint of the parent group
+// and any remaining members obtain access through a group this group has been shared with.
+func groupMembershipSources(ctx context.Context, conn *api.Client, groupId int) (func(int) string, error) {
+	direct, err := listGroupMemberIds(conn, groupId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	group, _, err := conn.Groups.GetGroup(groupId, &api.GetGroupOptions{WithProjects: api.Bool(false)})
+	if err != nil {
+		return nil, err
+	}
+
+	inherited := map[int]bool{}
+	if group.ParentID != 0 {
+		plugin.Logger(ctx).Debug("groupMembershipSources", "groupId", groupId, "parentId", group.ParentID)
+		inherited, err = listGroupMemberIds(conn, group.ParentID, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return membershipSourceResolver(direct, inherited), nil
+}
+
+// listGroupMemberIds returns the set of user IDs which are members of a group, optionally including inherited members.
+func listGroupMemberIds(conn *api.Client, groupId int, all bool) (map[int]bool, error) {
+	listMembers := conn.Groups.ListGroupMembers
+	if all {
+		listMembers = conn.Groups.ListAllGroupMembers
+	}
+
+	return listMemberIds(func(opt *api.ListOptions) ([]int, *api.Response, error) {
+		members, resp, err := listMembers(groupId, &api.ListGroupMembersOptions{ListOptions: *opt})
+		ids := make([]int, 0, len(members))
+		for _, member := range members {
+			ids = append(ids, member.ID)
+		}
+		return ids, resp, err
+	})
+}
+
+// listMemberIds pages through a members endpoint returning the set of user IDs.
+func listMemberIds(list func(opt *api.ListOptions) ([]int, *api.Response, error)) (map[int]bool, error) {
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	output := map[int]bool{}
+	for {
+		ids, resp, err := list(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			output[id] = true
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return output, nil
+}
+
+func membershipSourceResolver(direct map[int]bool, inherited map[int]bool) func(int) string {
+	return func(userId int) string {
+		switch {
+		case direct[userId]:
+			return membershipSourceDirect
+		case inherited[userId]:
+			return membershipSourceInherited
+		default:
+			return membershipSourceSharedGroup
+		}
+	}
+}
+
 // Column Functions
 func groupMemberColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -145,5 +274,16 @@ func groupMemberColumns() []*plugin.Column {
 			Type:        proto.ColumnType_INT,
 			Description: "The group id - link to gitlab_group.id`.",
 		},
+		{
+			Name:        "membership_source",
+			Type:        proto.ColumnType_STRING,
+			Description: "How the member obtained access to the group (direct, inherited from a parent group or shared_group).",
+		},
+		{
+			Name:        "inherited",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the membership is not a direct membership of the group, set to false to only return direct members.",
+			Transform:   transform.FromField("Inherited"),
+		},
 	}
 }
@@ -14,8 +14,18 @@ func tableGroupProject() *plugin.Table {
 		Name:        "gitlab_group_project",
 		Description: "Obtain information about the project(s) that reside within a group.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("group_id"),
-			Hydrate:    listGroupProjects,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "group_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "include_subgroups",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listGroupProjects,
 		},
 		Columns: groupProjectColumns(),
 	}
@@ -30,8 +40,13 @@ func listGroupProjects(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	q := d.EqualsQuals
+	groupId := int(q["group_id"].GetInt64Value())
 	includeSubGroups := true
+	if q["include_subgroups"] != nil {
+		includeSubGroups = q["include_subgroups"].GetBoolValue()
+		plugin.Logger(ctx).Debug("listGroupProjects", "filter[include_subgroups]", includeSubGroups)
+	}
 	opt := &api.ListGroupProjectsOptions{
 		IncludeSubGroups: &includeSubGroups,
 		ListOptions: api.ListOptions{
@@ -77,5 +92,11 @@ func groupProjectColumns() []*plugin.Column {
 		Description: "Group ID",
 		Transform:   transform.FromQual("group_id"),
 	}
-	return append(cols, &gic)
+	isc := plugin.Column{
+		Name:        "include_subgroups",
+		Type:        proto.ColumnType_BOOL,
+		Description: "Indicates if projects of subgroups are included (default true), set to false to only return projects directly within the group.",
+		Transform:   transform.FromQual("include_subgroups"),
+	}
+	return append(cols, &gic, &isc)
 }
@@ -43,8 +43,17 @@ func listGroupPushRules(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return nil, nil
 }
 
-// Column Function
+// Column Functions
 func groupPushRuleColumns() []*plugin.Column {
+	return append(pushRuleColumns(), &plugin.Column{
+		Name:        "group_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The group id - link to gitlab_group.id`.",
+		Transform:   transform.FromQual("group_id"),
+	})
+}
+
+func pushRuleColumns() []*plugin.Column {
 	return []*plugin.Column{
 		{
 			Name:        "id",
@@ -54,7 +63,7 @@ func groupPushRuleColumns() []*plugin.Column {
 		{
 			Name:        "created_at",
 			Type:        proto.ColumnType_TIMESTAMP,
-			Description: "Timestamp of when the group push rule was created.",
+			Description: "Timestamp of when the push rule was created.",
 		},
 		{
 			Name:        "commit_message_regex",
@@ -111,11 +120,5 @@ func groupPushRuleColumns() []*plugin.Column {
 			Type:        proto.ColumnType_BOOL,
 			Description: "Indicates if commits not signed by GPG will be rejected.",
 		},
-		{
-			Name:        "group_id",
-			Type:        proto.ColumnType_INT,
-			Description: "The group id - link to gitlab_group.id`.",
-			Transform:   transform.FromQual("group_id"),
-		},
 	}
 }
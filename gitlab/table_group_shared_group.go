@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableGroupSharedGroup() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_group_shared_group",
+		Description: "Obtain information about the groups a specific group has been shared with within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("group_id"),
+			Hydrate:    listGroupSharedGroups,
+		},
+		Columns: groupSharedGroupColumns(),
+	}
+}
+
+// Hydrate Functions
+func listGroupSharedGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupSharedGroups", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupSharedGroups", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("listGroupSharedGroups", "groupId", groupId)
+
+	group, _, err := conn.Groups.GetGroup(groupId, &api.GetGroupOptions{WithProjects: api.Bool(false)})
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupSharedGroups", "groupId", groupId, "error", err)
+		return nil, fmt.Errorf("unable to obtain shared groups for group_id %d\n%v", groupId, err)
+	}
+
+	for _, shared := range group.SharedWithGroups {
+		d.StreamListItem(ctx, &SharedGroup{
+			GroupID:     shared.GroupID,
+			Name:        shared.GroupName,
+			FullPath:    shared.GroupFullPath,
+			AccessLevel: shared.GroupAccessLevel,
+			AccessDesc:  parseAccessLevel(shared.GroupAccessLevel),
+			ExpiresAt:   shared.ExpiresAt,
+		})
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listGroupSharedGroups", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func groupSharedGroupColumns() []*plugin.Column {
+	return append(sharedGroupColumns(), &plugin.Column{
+		Name:        "group_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the group which has been shared - link to `gitlab_group.id`.",
+		Transform:   transform.FromQual("group_id"),
+	})
+}
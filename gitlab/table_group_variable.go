@@ -39,6 +39,12 @@ func listGroupVars(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
+	mode, err := variableValueMode(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupVars", "error", err)
+		return nil, err
+	}
+
 	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
 	opt := &api.ListGroupVariablesOptions{
 		Page:    1,
@@ -55,7 +61,7 @@ func listGroupVars(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		}
 
 		for _, v := range vars {
-			d.StreamListItem(ctx, v)
+			d.StreamListItem(ctx, parseCIVariable(mode, v))
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				plugin.Logger(ctx).Debug("listGroupVars", "completed successfully")
@@ -82,6 +88,12 @@ func getGroupVar(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
+	mode, err := variableValueMode(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getGroupVar", "error", err)
+		return nil, err
+	}
+
 	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
 	key := d.EqualsQuals["key"].GetStringValue()
 	plugin.Logger(ctx).Debug("getGroupVar", "groupId", groupId, "key", key)
@@ -93,7 +105,7 @@ func getGroupVar(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	}
 
 	plugin.Logger(ctx).Debug("getGroupVar", "completed successfully")
-	return v, nil
+	return parseCIVariable(mode, v), nil
 }
 
 // Column Function
@@ -107,7 +119,19 @@ func groupVarColumns() []*plugin.Column {
 		{
 			Name:        "value",
 			Type:        proto.ColumnType_STRING,
-			Description: "The value of the variable.",
+			Description: "The value of the variable (plaintext, redacted, sha256 hashed or omitted as per the variable_value_mode of the connection).",
+		},
+		{
+			Name:        "value_length",
+			Type:        proto.ColumnType_INT,
+			Description: "The length in characters of the value of the variable.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "looks_like_secret",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the variable appears to hold a secret (based upon its key, a well known token format or the randomness of its value).",
+			Transform:   transform.FromGo(),
 		},
 		{
 			Name:        "variable_type",
@@ -0,0 +1,75 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableInstanceFeature() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_instance_feature",
+		Description: "Obtain information on the feature flags of the GitLab instance itself (only available in self-hosted model, requires admin access).",
+		List: &plugin.ListConfig{
+			Hydrate: listInstanceFeatures,
+		},
+		Columns: instanceFeatureColumns(),
+	}
+}
+
+// Hydrate Functions
+func listInstanceFeatures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listInstanceFeatures", "started")
+	// Not available on public, only self-hosted.
+	if isPublicGitLab(d) {
+		plugin.Logger(ctx).Warn("listInstanceFeatures", "non-self hosted instance - exiting with empty result set")
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listInstanceFeatures", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	features, _, err := conn.Features.ListFeatures()
+	if err != nil {
+		plugin.Logger(ctx).Error("listInstanceFeatures", "error", err)
+		return nil, fmt.Errorf("unable to obtain instance features\n%v", err)
+	}
+
+	for _, feature := range features {
+		d.StreamListItem(ctx, feature)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			plugin.Logger(ctx).Debug("listInstanceFeatures", "completed successfully")
+			return nil, nil
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listInstanceFeatures", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func instanceFeatureColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the feature.",
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state of the feature (on, off or conditional).",
+		},
+		{
+			Name:        "gates",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the gates of the feature (e.g. boolean, actors, groups or percentage_of_time) and their values.",
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
 )
 
@@ -34,6 +35,12 @@ func listInstanceVars(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
+	mode, err := variableValueMode(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listInstanceVars", "error", err)
+		return nil, err
+	}
+
 	opt := &api.ListInstanceVariablesOptions{
 		Page:    1,
 		PerPage: 50,
@@ -49,7 +56,7 @@ func listInstanceVars(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		}
 
 		for _, v := range vars {
-			d.StreamListItem(ctx, v)
+			d.StreamListItem(ctx, parseCIVariable(mode, v))
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				plugin.Logger(ctx).Debug("listInstanceVars", "completed successfully")
@@ -79,7 +86,19 @@ func instanceVarColumns() []*plugin.Column {
 		{
 			Name:        "value",
 			Type:        proto.ColumnType_STRING,
-			Description: "The value of the variable.",
+			Description: "The value of the variable (plaintext, redacted, sha256 hashed or omitted as per the variable_value_mode of the connection).",
+		},
+		{
+			Name:        "value_length",
+			Type:        proto.ColumnType_INT,
+			Description: "The length in characters of the value of the variable.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "looks_like_secret",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the variable appears to hold a secret (based upon its key, a well known token format or the randomness of its value).",
+			Transform:   transform.FromGo(),
 		},
 		{
 			Name:        "variable_type",
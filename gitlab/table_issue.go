@@ -3,12 +3,34 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
 )
 
+// issueFilter holds the qualifiers which can be pushed down to the issue list endpoints.
+type issueFilter struct {
+	AssigneeUsername *string
+	AssigneeID       *api.AssigneeIDValue
+	AuthorID         *int
+	Confidential     *bool
+	State            *string
+	Milestone        *string
+	IterationID      *int
+	Search           *string
+	Weight           *int
+	IssueType        *string
+	CreatedAfter     *time.Time
+	CreatedBefore    *time.Time
+	UpdatedAfter     *time.Time
+	UpdatedBefore    *time.Time
+	LabelSets        []*api.Labels
+}
+
 func tableIssue() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_issue",
@@ -21,6 +43,16 @@ func tableIssue() *plugin.Table {
 				{Name: "author_id", Require: plugin.Optional},
 				{Name: "confidential", Require: plugin.Optional},
 				{Name: "project_id", Require: plugin.Optional},
+				{Name: "group_id", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"?", "?|", "?&"}},
+				{Name: "milestone_title", Require: plugin.Optional},
+				{Name: "iteration_id", Require: plugin.Optional},
+				{Name: "search", Require: plugin.Optional},
+				{Name: "weight", Require: plugin.Optional},
+				{Name: "issue_type", Require: plugin.Optional},
+				{Name: "created_at", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
 			},
 		},
 		Columns: issueColumns(),
@@ -34,9 +66,10 @@ func listIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		q["assignee_id"] == nil &&
 		q["author_id"] == nil &&
 		q["project_id"] == nil &&
+		q["group_id"] == nil &&
 		isPublicGitLab(d) {
-		plugin.Logger(ctx).Error("listIssues", "Public GitLab requires an '=' qualifier for at least one of the following columns 'assignee', 'assignee_id', 'author_id', 'project_id' - none was provided")
-		return nil, fmt.Errorf("when using the gitlab_issue table with GitLab Cloud, `List` call requires an '=' qualifier for one or more of the following columns: 'assignee', 'assignee_id', 'author_id', 'project_id'")
+		plugin.Logger(ctx).Error("listIssues", "Public GitLab requires an '=' qualifier for at least one of the following columns 'assignee', 'assignee_id', 'author_id', 'project_id', 'group_id' - none was provided")
+		return nil, fmt.Errorf("when using the gitlab_issue table with GitLab Cloud, `List` call requires an '=' qualifier for one or more of the following columns: 'assignee', 'assignee_id', 'author_id', 'project_id', 'group_id'")
 	}
 
 	if q["project_id"] != nil {
@@ -44,6 +77,11 @@ func listIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return listProjectIssues(ctx, d, h)
 	}
 
+	if q["group_id"] != nil {
+		plugin.Logger(ctx).Debug("listIssues", "group_id qualifier obtained, re-directing SDK call to ListGroupIssues")
+		return listGroupIssues(ctx, d, h)
+	}
+
 	return listAllIssues(ctx, d, h)
 }
 
@@ -69,34 +107,58 @@ func listProjectIssues(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		},
 	}
 
-	opt = addOptionalProjectIssueQualifiers(ctx, opt, q)
+	filter := parseIssueQualifiers(ctx, d)
+	opt = addOptionalProjectIssueQualifiers(opt, filter)
 	projectId := int(q["project_id"].GetInt64Value())
 
-	for {
-		plugin.Logger(ctx).Debug("listProjectIssues", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		issues, resp, err := conn.Issues.ListProjectIssues(projectId, opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listProjectIssues", "projectId", projectId, "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain issues for project_id %d\n%v", projectId, err)
-		}
+	err = streamIssues(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.Issue, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listProjectIssues", "projectId", projectId, "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.Issues.ListProjectIssues(projectId, opt, filter.requestOptions()...)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectIssues", "projectId", projectId, "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain issues for project_id %d\n%v", projectId, err)
+	}
 
-		for _, issue := range issues {
-			d.StreamListItem(ctx, issue)
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				plugin.Logger(ctx).Debug("listProjectIssues", "completed successfully")
-				return nil, nil
-			}
-		}
+	plugin.Logger(ctx).Debug("listProjectIssues", "completed successfully")
+	return nil, nil
+}
 
-		if resp.NextPage == 0 {
-			break
-		}
+func listGroupIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupIssues", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupIssues", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
 
-		opt.Page = resp.NextPage
+	defaultScope := "all"
+	opt := &api.ListGroupIssuesOptions{
+		Scope: &defaultScope,
+		ListOptions: api.ListOptions{
+			Page:    1,
+			PerPage: 50,
+		},
 	}
 
-	plugin.Logger(ctx).Debug("listProjectIssues", "completed successfully")
+	filter := parseIssueQualifiers(ctx, d)
+	opt = addOptionalGroupIssueQualifiers(opt, filter)
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+
+	err = streamIssues(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.Issue, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listGroupIssues", "groupId", groupId, "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.Issues.ListGroupIssues(groupId, opt, filter.groupRequestOptions()...)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupIssues", "groupId", groupId, "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain issues for group_id %d\n%v", groupId, err)
+	}
+
+	plugin.Logger(ctx).Debug("listGroupIssues", "completed successfully")
 	return nil, nil
 }
 
@@ -108,7 +170,6 @@ func listAllIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	q := d.EqualsQuals
 	defaultScope := "all"
 	opt := &api.ListIssuesOptions{
 		Scope: &defaultScope,
@@ -117,29 +178,19 @@ func listAllIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 			PerPage: 50,
 		},
 	}
-	opt = addOptionalIssueQualifiers(ctx, opt, q)
-
-	for {
-		plugin.Logger(ctx).Debug("listAllIssues", "page", opt.Page, "perPage", opt.PerPage)
-		issues, resp, err := conn.Issues.ListIssues(opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listAllIssues", "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain issues\n%v", err)
-		}
 
-		for _, issue := range issues {
-			d.StreamListItem(ctx, issue)
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				plugin.Logger(ctx).Debug("listAllIssues", "completed successfully")
-				return nil, nil
-			}
-		}
+	filter := parseIssueQualifiers(ctx, d)
+	opt = addOptionalIssueQualifiers(opt, filter)
 
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
+	err = streamIssues(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.Issue, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listAllIssues", "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.Issues.ListIssues(opt, filter.requestOptions()...)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAllIssues", "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain issues\n%v", err)
 	}
 
 	plugin.Logger(ctx).Debug("listAllIssues", "completed successfully")
@@ -147,59 +198,187 @@ func listAllIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 }
 
 // Assist Functions
-func addOptionalProjectIssueQualifiers(ctx context.Context, opts *api.ListProjectIssuesOptions, q map[string]*proto.QualValue) *api.ListProjectIssuesOptions {
+
+// streamIssues pages through the issues returned for each set of labels, the results of multiple label sets (from a ?| qualifier) are de-duplicated.
+func streamIssues(ctx context.Context, d *plugin.QueryData, labelSets []*api.Labels, list func(labels *api.Labels, page int) ([]*api.Issue, *api.Response, error)) error {
+	seen := make(map[int]bool)
+	for _, labels := range labelSets {
+		page := 1
+		for {
+			issues, resp, err := list(labels, page)
+			if err != nil {
+				return err
+			}
+
+			for _, issue := range issues {
+				if seen[issue.ID] {
+					continue
+				}
+				seen[issue.ID] = true
+
+				d.StreamListItem(ctx, issue)
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			page = resp.NextPage
+		}
+	}
+
+	return nil
+}
+
+// parseIssueQualifiers translates the qualifiers of the gitlab_issue table into an issueFilter.
+func parseIssueQualifiers(ctx context.Context, d *plugin.QueryData) *issueFilter {
+	q := d.EqualsQuals
+	filter := &issueFilter{}
+
 	if q["assignee"] != nil {
 		assignee := q["assignee"].GetStringValue()
-		opts.AssigneeUsername = &assignee
-		plugin.Logger(ctx).Debug("listProjectIssues", "filter[assignee]", assignee)
+		filter.AssigneeUsername = &assignee
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[assignee]", assignee)
 	}
 
 	if q["assignee_id"] != nil {
 		assigneeId := int(q["assignee_id"].GetInt64Value())
-		opts.AssigneeID = api.AssigneeID(assigneeId)
-		plugin.Logger(ctx).Debug("listProjectIssues", "filter[assignee_id]", assigneeId)
+		filter.AssigneeID = api.AssigneeID(assigneeId)
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[assignee_id]", assigneeId)
 	}
 
 	if q["author_id"] != nil {
 		authorId := int(q["author_id"].GetInt64Value())
-		opts.AuthorID = &authorId
-		plugin.Logger(ctx).Debug("listProjectIssues", "filter[author_id]", authorId)
+		filter.AuthorID = &authorId
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[author_id]", authorId)
 	}
 
 	if q["confidential"] != nil {
 		confidential := q["confidential"].GetBoolValue()
-		opts.Confidential = &confidential
-		plugin.Logger(ctx).Debug("listProjectIssues", "filter[confidential]", confidential)
+		filter.Confidential = &confidential
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[confidential]", confidential)
 	}
 
-	return opts
-}
+	if q["state"] != nil {
+		state := q["state"].GetStringValue()
+		filter.State = &state
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[state]", state)
+	}
 
-func addOptionalIssueQualifiers(ctx context.Context, opts *api.ListIssuesOptions, q map[string]*proto.QualValue) *api.ListIssuesOptions {
-	if q["assignee"] != nil {
-		assignee := q["assignee"].GetStringValue()
-		opts.AssigneeUsername = &assignee
-		plugin.Logger(ctx).Debug("listAllIssues", "filter[assignee]", assignee)
+	if q["milestone_title"] != nil {
+		milestone := q["milestone_title"].GetStringValue()
+		filter.Milestone = &milestone
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[milestone]", milestone)
 	}
 
-	if q["assignee_id"] != nil {
-		assigneeId := int(q["assignee_id"].GetInt64Value())
-		opts.AssigneeID = api.AssigneeID(assigneeId)
-		plugin.Logger(ctx).Debug("listAllIssues", "filter[assignee_id]", assigneeId)
+	if q["iteration_id"] != nil {
+		iterationId := int(q["iteration_id"].GetInt64Value())
+		filter.IterationID = &iterationId
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[iteration_id]", iterationId)
 	}
 
-	if q["author_id"] != nil {
-		authorId := int(q["author_id"].GetInt64Value())
-		opts.AuthorID = &authorId
-		plugin.Logger(ctx).Debug("listAllIssues", "filter[author_id]", authorId)
+	if q["search"] != nil {
+		search := q["search"].GetStringValue()
+		filter.Search = &search
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[search]", search)
 	}
 
-	if q["confidential"] != nil {
-		confidential := q["confidential"].GetBoolValue()
-		opts.Confidential = &confidential
-		plugin.Logger(ctx).Debug("listAllIssues", "filter[confidential]", confidential)
+	// A weight of 0 is left to Postgres, as the SDK doesn't distinguish it from no weight whereas the API does.
+	if q["weight"] != nil && q["weight"].GetInt64Value() > 0 {
+		weight := int(q["weight"].GetInt64Value())
+		filter.Weight = &weight
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[weight]", weight)
 	}
 
+	if q["issue_type"] != nil {
+		issueType := q["issue_type"].GetStringValue()
+		filter.IssueType = &issueType
+		plugin.Logger(ctx).Debug("parseIssueQualifiers", "filter[issue_type]", issueType)
+	}
+
+	filter.CreatedAfter, filter.CreatedBefore = timeRangeQualifiers(d, "created_at")
+	filter.UpdatedAfter, filter.UpdatedBefore = timeRangeQualifiers(d, "updated_at")
+	filter.LabelSets = labelSetQualifiers(d, "labels")
+
+	return filter
+}
+
+// requestOptions returns the request options for qualifiers the SDK list options don't support.
+func (f *issueFilter) requestOptions() []api.RequestOptionFunc {
+	var options []api.RequestOptionFunc
+	if f.Weight != nil {
+		options = append(options, withQueryParam("weight", strconv.Itoa(*f.Weight)))
+	}
+
+	return options
+}
+
+// groupRequestOptions returns the request options for the group issues endpoint, which supports confidential although
+// the SDK's group list options don't.
+func (f *issueFilter) groupRequestOptions() []api.RequestOptionFunc {
+	options := f.requestOptions()
+	if f.Confidential != nil {
+		options = append(options, withQueryParam("confidential", strconv.FormatBool(*f.Confidential)))
+	}
+
+	return options
+}
+
+func addOptionalProjectIssueQualifiers(opts *api.ListProjectIssuesOptions, f *issueFilter) *api.ListProjectIssuesOptions {
+	opts.AssigneeUsername = f.AssigneeUsername
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.Confidential = f.Confidential
+	opts.State = f.State
+	opts.Milestone = f.Milestone
+	opts.IterationID = f.IterationID
+	opts.Search = f.Search
+	opts.IssueType = f.IssueType
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
+	return opts
+}
+
+// addOptionalGroupIssueQualifiers doesn't set confidential as the SDK's group list options lack it, see groupRequestOptions.
+func addOptionalGroupIssueQualifiers(opts *api.ListGroupIssuesOptions, f *issueFilter) *api.ListGroupIssuesOptions {
+	opts.AssigneeUsername = f.AssigneeUsername
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.State = f.State
+	opts.Milestone = f.Milestone
+	opts.IterationID = f.IterationID
+	opts.Search = f.Search
+	opts.IssueType = f.IssueType
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
+	return opts
+}
+
+func addOptionalIssueQualifiers(opts *api.ListIssuesOptions, f *issueFilter) *api.ListIssuesOptions {
+	opts.AssigneeUsername = f.AssigneeUsername
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.Confidential = f.Confidential
+	opts.State = f.State
+	opts.Milestone = f.Milestone
+	opts.IterationID = f.IterationID
+	opts.Search = f.Search
+	opts.IssueType = f.IssueType
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
 	return opts
 }
 
@@ -479,6 +658,18 @@ func issueColumns() []*plugin.Column {
 			Description: "Total time spent on the issue.",
 			Transform:   transform.FromField("TimeStats.TotalTimeSpent"),
 		},
+		{
+			Name:        "human_time_estimate",
+			Type:        proto.ColumnType_STRING,
+			Description: "Time estimated against the issue in human readable format (e.g. 1d 4h).",
+			Transform:   transform.FromField("TimeStats.HumanTimeEstimate").NullIfZero(),
+		},
+		{
+			Name:        "human_total_time_spent",
+			Type:        proto.ColumnType_STRING,
+			Description: "Total time spent on the issue in human readable format (e.g. 1d 4h).",
+			Transform:   transform.FromField("TimeStats.HumanTotalTimeSpent").NullIfZero(),
+		},
 		// IDs
 		{
 			Name:        "issue_link_id",
@@ -521,5 +712,31 @@ func issueColumns() []*plugin.Column {
 			Description: "The group ID of the associated epic.",
 			Transform:   transform.FromField("Epic.GroupID"),
 		},
+		// Iteration
+		{
+			Name:        "iteration_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the iteration the issue is placed into - link to `gitlab_group_iteration.id`.",
+			Transform:   transform.FromField("Iteration.ID"),
+		},
+		{
+			Name:        "iteration_title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the iteration the issue is placed into.",
+			Transform:   transform.FromField("Iteration.Title"),
+		},
+		// Qualifiers
+		{
+			Name:        "search",
+			Type:        proto.ColumnType_STRING,
+			Description: "The search term used to filter the issues by title and description.",
+			Transform:   transform.FromQual("search"),
+		},
+		{
+			Name:        "group_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the group to obtain issues for (including issues of subgroups) - link to `gitlab_group.id`.",
+			Transform:   transform.FromQual("group_id"),
+		},
 	}
 }
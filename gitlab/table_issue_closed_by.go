@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableIssueClosedBy() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_closed_by",
+		Description: "Obtain information about the merge requests which will close a specific issue when merged from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueClosedBy,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueMergeRequestColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueClosedBy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueClosedBy", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueClosedBy", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ListMergeRequestsClosingIssueOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueClosedBy", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		mergeRequests, resp, err := conn.Issues.ListMergeRequestsClosingIssue(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueClosedBy", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain merge requests closing issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, mergeRequest := range mergeRequests {
+			d.StreamListItem(ctx, mergeRequest)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueClosedBy", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listIssueClosedBy", "completed successfully")
+	return nil, nil
+}
@@ -0,0 +1,159 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableIssueLabelEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_label_event",
+		Description: "Obtain the history of labels being added to or removed from a specific issue from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueLabelEvents,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueLabelEventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueLabelEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueLabelEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueLabelEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ListLabelEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueLabelEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		events, resp, err := conn.ResourceLabelEvents.ListIssueLabelEvents(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueLabelEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain label events for issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueLabelEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listIssueLabelEvents", "completed successfully")
+	return nil, nil
+}
+
+// Column Functions
+func issueResourceEventColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the event.",
+		},
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the issue the event belongs to.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the issue belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "resource_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of resource the event belongs to.",
+		},
+		{
+			Name:        "resource_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the resource the event belongs to.",
+			Transform:   transform.FromField("ResourceID"),
+		},
+		{
+			Name:        "user_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user who triggered the event - link to `gitlab_user.id`.",
+			Transform:   transform.FromField("User.ID"),
+		},
+		{
+			Name:        "user_username",
+			Type:        proto.ColumnType_STRING,
+			Description: "The username of the user who triggered the event - link to `gitlab_user.username`.",
+			Transform:   transform.FromField("User.Username"),
+		},
+		{
+			Name:        "user_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The display name of the user who triggered the event.",
+			Transform:   transform.FromField("User.Name"),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the event occurred.",
+		},
+	}
+}
+
+func issueLabelEventColumns() []*plugin.Column {
+	return append(issueResourceEventColumns(),
+		&plugin.Column{
+			Name:        "action",
+			Type:        proto.ColumnType_STRING,
+			Description: "The action performed on the label (add or remove).",
+		},
+		&plugin.Column{
+			Name:        "label_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the label - null if the label has since been deleted.",
+			Transform:   transform.FromField("Label.ID").NullIfZero(),
+		},
+		&plugin.Column{
+			Name:        "label_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the label.",
+			Transform:   transform.FromField("Label.Name").NullIfZero(),
+		},
+		&plugin.Column{
+			Name:        "label_color",
+			Type:        proto.ColumnType_STRING,
+			Description: "The background color of the label.",
+			Transform:   transform.FromField("Label.Color").NullIfZero(),
+		},
+		&plugin.Column{
+			Name:        "label_description",
+			Type:        proto.ColumnType_STRING,
+			Description: "The description of the label.",
+			Transform:   transform.FromField("Label.Description").NullIfZero(),
+		},
+	)
+}
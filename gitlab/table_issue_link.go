@@ -0,0 +1,195 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableIssueLink() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_link",
+		Description: "Obtain information about the issues linked to a specific issue (blocks, is_blocked_by, relates_to) from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueLinks,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueLinkColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueLinks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueLinks", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueLinks", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+
+	plugin.Logger(ctx).Debug("listIssueLinks", "projectId", projectId, "iid", iid)
+	relations, _, err := conn.IssueLinks.ListIssueRelations(projectId, iid)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueLinks", "projectId", projectId, "iid", iid, "error", err)
+		return nil, fmt.Errorf("unable to obtain links for issue %d for project_id %d\n%v", iid, projectId, err)
+	}
+
+	for _, relation := range relations {
+		d.StreamListItem(ctx, relation)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listIssueLinks", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func issueLinkColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the issue the links belong to.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the issue belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "link_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the issue link.",
+			Transform:   transform.FromField("IssueLinkID"),
+		},
+		{
+			Name:        "link_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of the link from the issue to the linked issue (relates_to, blocks or is_blocked_by).",
+		},
+		{
+			Name:        "link_created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the link was created.",
+		},
+		{
+			Name:        "link_updated_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the link was last updated.",
+		},
+		{
+			Name:        "linked_issue_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the linked issue.",
+			Transform:   transform.FromField("ID"),
+		},
+		{
+			Name:        "linked_issue_iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the linked issue.",
+			Transform:   transform.FromField("IID"),
+		},
+		{
+			Name:        "linked_project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the linked issue belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromField("ProjectID"),
+		},
+		{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the linked issue.",
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state of the linked issue (opened, closed, etc).",
+		},
+		{
+			Name:        "confidential",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the linked issue is marked as confidential.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "author_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the author of the linked issue - link to `gitlab_user.id`.",
+			Transform:   transform.FromField("Author.ID"),
+		},
+		{
+			Name:        "author",
+			Type:        proto.ColumnType_STRING,
+			Description: "The username of the author of the linked issue - link to `gitlab_user.username`.",
+			Transform:   transform.FromField("Author.Username"),
+		},
+		{
+			Name:        "assignees",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of usernames assigned to the linked issue.",
+			Transform:   transform.FromField("Assignees").Transform(parseAssignees),
+		},
+		{
+			Name:        "labels",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of labels applied to the linked issue.",
+		},
+		{
+			Name:        "milestone_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the milestone the linked issue is placed into.",
+			Transform:   transform.FromField("Milestone.ID"),
+		},
+		{
+			Name:        "milestone_title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the milestone the linked issue is placed into.",
+			Transform:   transform.FromField("Milestone.Title"),
+		},
+		{
+			Name:        "weight",
+			Type:        proto.ColumnType_INT,
+			Description: "The weight assigned to the linked issue.",
+		},
+		{
+			Name:        "due_date",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of due date for the linked issue to be completed by.",
+			Transform:   transform.FromField("DueDate").NullIfZero().Transform(isoTimeTransform),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of creation of the linked issue.",
+		},
+		{
+			Name:        "updated_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of last update to the linked issue.",
+		},
+		{
+			Name:        "reference",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full reference of the linked issue.",
+			Transform:   transform.FromField("References.Full"),
+		},
+		{
+			Name:        "web_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url to access the linked issue.",
+			Transform:   transform.FromField("WebURL"),
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableIssueMilestoneEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_milestone_event",
+		Description: "Obtain the history of milestones being added to or removed from a specific issue from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueMilestoneEvents,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueMilestoneEventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueMilestoneEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueMilestoneEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueMilestoneEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ListMilestoneEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueMilestoneEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		events, resp, err := conn.ResourceMilestoneEvents.ListIssueMilestoneEvents(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueMilestoneEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain milestone events for issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueMilestoneEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listIssueMilestoneEvents", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func issueMilestoneEventColumns() []*plugin.Column {
+	return append(issueResourceEventColumns(),
+		&plugin.Column{
+			Name:        "action",
+			Type:        proto.ColumnType_STRING,
+			Description: "The action performed on the milestone (add or remove).",
+		},
+		&plugin.Column{
+			Name:        "milestone_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the milestone.",
+			Transform:   transform.FromField("Milestone.ID"),
+		},
+		&plugin.Column{
+			Name:        "milestone_iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the milestone.",
+			Transform:   transform.FromField("Milestone.IID"),
+		},
+		&plugin.Column{
+			Name:        "milestone_title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the milestone.",
+			Transform:   transform.FromField("Milestone.Title"),
+		},
+		&plugin.Column{
+			Name:        "milestone_due_date",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of the due date of the milestone.",
+			Transform:   transform.FromField("Milestone.DueDate").NullIfZero().Transform(isoTimeTransform),
+		},
+	)
+}
@@ -0,0 +1,174 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableIssueRelatedMergeRequest() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_related_merge_request",
+		Description: "Obtain information about the merge requests related to a specific issue from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueRelatedMergeRequests,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueMergeRequestColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueRelatedMergeRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueRelatedMergeRequests", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueRelatedMergeRequests", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ListMergeRequestsRelatedToIssueOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueRelatedMergeRequests", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		mergeRequests, resp, err := conn.Issues.ListMergeRequestsRelatedToIssue(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueRelatedMergeRequests", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain related merge requests for issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, mergeRequest := range mergeRequests {
+			d.StreamListItem(ctx, mergeRequest)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueRelatedMergeRequests", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listIssueRelatedMergeRequests", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func issueMergeRequestColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the issue.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the issue belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "merge_request_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the merge request.",
+			Transform:   transform.FromField("ID"),
+		},
+		{
+			Name:        "merge_request_iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the merge request - link to `gitlab_merge_request.iid`.",
+			Transform:   transform.FromField("IID"),
+		},
+		{
+			Name:        "merge_request_project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the merge request belongs to - link to `gitlab_merge_request.project_id`.",
+			Transform:   transform.FromField("ProjectID"),
+		},
+		{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the merge request.",
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state of the merge request (opened, closed, merged, locked).",
+		},
+		{
+			Name:        "draft",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the merge request is a draft.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "source_branch",
+			Type:        proto.ColumnType_STRING,
+			Description: "The source branch of the merge request.",
+		},
+		{
+			Name:        "target_branch",
+			Type:        proto.ColumnType_STRING,
+			Description: "The target branch of the merge request.",
+		},
+		{
+			Name:        "author_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the author of the merge request - link to `gitlab_user.id`.",
+			Transform:   transform.FromField("Author.ID"),
+		},
+		{
+			Name:        "author_username",
+			Type:        proto.ColumnType_STRING,
+			Description: "The username of the author of the merge request - link to `gitlab_user.username`.",
+			Transform:   transform.FromField("Author.Username"),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the merge request was created.",
+		},
+		{
+			Name:        "updated_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the merge request was last updated.",
+		},
+		{
+			Name:        "merged_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the merge request was merged.",
+		},
+		{
+			Name:        "closed_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the merge request was closed.",
+		},
+		{
+			Name:        "reference",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full reference of the merge request.",
+			Transform:   transform.FromField("References.Full"),
+		},
+		{
+			Name:        "web_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url to access the merge request.",
+			Transform:   transform.FromField("WebURL"),
+		},
+	}
+}
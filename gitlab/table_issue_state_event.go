@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableIssueStateEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_state_event",
+		Description: "Obtain the history of state changes (opened, closed, reopened) of a specific issue from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueStateEvents,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueStateEventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueStateEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueStateEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueStateEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ListStateEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueStateEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		events, resp, err := conn.ResourceStateEvents.ListIssueStateEvents(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueStateEvents", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain state events for issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueStateEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listIssueStateEvents", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func issueStateEventColumns() []*plugin.Column {
+	return append(issueResourceEventColumns(),
+		&plugin.Column{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state the issue was changed to (opened, closed or reopened).",
+		},
+	)
+}
@@ -0,0 +1,272 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Timelogs are not exposed by the REST API, so they're obtained from the GraphQL API.
+const issueTimelogQuery = `query($ids: [ID!], $iid: String!, $after: String) {
+  projects(ids: $ids) {
+    nodes {
+      issue(iid: $iid) {
+        timelogs(first: 100, after: $after) {
+          pageInfo {
+            hasNextPage
+            endCursor
+          }
+          nodes {
+            id
+            timeSpent
+            spentAt
+            summary
+            user {
+              id
+              username
+              name
+            }
+            note {
+              id
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type IssueTimelog struct {
+	ID             int
+	TimeSpent      int
+	HumanTimeSpent string
+	SpentAt        *time.Time
+	Summary        string
+	UserID         int
+	UserUsername   string
+	UserName       string
+	NoteID         int
+}
+
+type timelogNode struct {
+	ID        string     `json:"id"`
+	TimeSpent int        `json:"timeSpent"`
+	SpentAt   *time.Time `json:"spentAt"`
+	Summary   string     `json:"summary"`
+	User      *struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Note *struct {
+		ID string `json:"id"`
+	} `json:"note"`
+}
+
+type issueTimelogData struct {
+	Projects struct {
+		Nodes []struct {
+			Issue *struct {
+				Timelogs struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []timelogNode `json:"nodes"`
+				} `json:"timelogs"`
+			} `json:"issue"`
+		} `json:"nodes"`
+	} `json:"projects"`
+}
+
+func tableIssueTimelog() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_issue_timelog",
+		Description: "Obtain the individual time entries logged against a specific issue from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listIssueTimelogs,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: issueTimelogColumns(),
+	}
+}
+
+// Hydrate Functions
+func listIssueTimelogs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listIssueTimelogs", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listIssueTimelogs", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	variables := map[string]interface{}{
+		"ids": []string{fmt.Sprintf("gid://gitlab/Project/%d", projectId)},
+		"iid": fmt.Sprintf("%d", iid),
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listIssueTimelogs", "projectId", projectId, "iid", iid, "after", variables["after"])
+		var data issueTimelogData
+		err = graphQLQuery(conn, issueTimelogQuery, variables, &data)
+		if err != nil {
+			plugin.Logger(ctx).Error("listIssueTimelogs", "projectId", projectId, "iid", iid, "error", err)
+			return nil, fmt.Errorf("unable to obtain timelogs for issue %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		if len(data.Projects.Nodes) == 0 || data.Projects.Nodes[0].Issue == nil {
+			plugin.Logger(ctx).Warn("listIssueTimelogs", "projectId", projectId, "iid", iid, "no issue was found, returning empty result set")
+			return nil, nil
+		}
+
+		timelogs := data.Projects.Nodes[0].Issue.Timelogs
+		for _, timelog := range timelogs.Nodes {
+			d.StreamListItem(ctx, parseTimelog(timelog))
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listIssueTimelogs", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if !timelogs.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["after"] = timelogs.PageInfo.EndCursor
+	}
+
+	plugin.Logger(ctx).Debug("listIssueTimelogs", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+func parseTimelog(node timelogNode) *IssueTimelog {
+	timelog := &IssueTimelog{
+		ID:             parseGlobalID(node.ID),
+		TimeSpent:      node.TimeSpent,
+		HumanTimeSpent: humanTimeSpent(node.TimeSpent),
+		SpentAt:        node.SpentAt,
+		Summary:        node.Summary,
+	}
+
+	if node.User != nil {
+		timelog.UserID = parseGlobalID(node.User.ID)
+		timelog.UserUsername = node.User.Username
+		timelog.UserName = node.User.Name
+	}
+
+	if node.Note != nil {
+		timelog.NoteID = parseGlobalID(node.Note.ID)
+	}
+
+	return timelog
+}
+
+// humanTimeSpent formats seconds in the same way as GitLab (e.g. 1w 2d 3h 30m), using 8 hour days and 5 day weeks.
+func humanTimeSpent(seconds int) string {
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	units := []struct {
+		suffix  string
+		seconds int
+	}{
+		{"w", 5 * 8 * 60 * 60},
+		{"d", 8 * 60 * 60},
+		{"h", 60 * 60},
+		{"m", 60},
+		{"s", 1},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		if seconds >= unit.seconds {
+			parts = append(parts, fmt.Sprintf("%d%s", seconds/unit.seconds, unit.suffix))
+			seconds %= unit.seconds
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0h"
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+// Column Function
+func issueTimelogColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the timelog.",
+		},
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the issue the time was logged against.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the issue belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "time_spent",
+			Type:        proto.ColumnType_INT,
+			Description: "The time spent in seconds (negative when time was subtracted).",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "human_time_spent",
+			Type:        proto.ColumnType_STRING,
+			Description: "The time spent in human readable format (e.g. 1h 30m).",
+		},
+		{
+			Name:        "spent_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the time was spent.",
+		},
+		{
+			Name:        "summary",
+			Type:        proto.ColumnType_STRING,
+			Description: "The summary of the time entry.",
+		},
+		{
+			Name:        "user_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user who logged the time - link to `gitlab_user.id`.",
+			Transform:   transform.FromField("UserID"),
+		},
+		{
+			Name:        "user_username",
+			Type:        proto.ColumnType_STRING,
+			Description: "The username of the user who logged the time - link to `gitlab_user.username`.",
+		},
+		{
+			Name:        "user_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The display name of the user who logged the time.",
+		},
+		{
+			Name:        "note_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the note (comment) the time was logged with, if any.",
+			Transform:   transform.FromField("NoteID"),
+		},
+	}
+}
@@ -3,12 +3,32 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
 )
 
+// mergeRequestFilter holds the qualifiers which can be pushed down to the merge request list endpoints.
+type mergeRequestFilter struct {
+	AssigneeID    *api.AssigneeIDValue
+	AuthorID      *int
+	ReviewerID    *api.ReviewerIDValue
+	State         *string
+	SourceBranch  *string
+	TargetBranch  *string
+	Draft         *bool
+	Milestone     *string
+	Search        *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	LabelSets     []*api.Labels
+}
+
 func tableMergeRequest() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_merge_request",
@@ -21,9 +41,20 @@ func tableMergeRequest() *plugin.Table {
 			Hydrate: listMergeRequests,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "project_id", Require: plugin.Optional},
+				{Name: "group_id", Require: plugin.Optional},
 				{Name: "author_id", Require: plugin.Optional},
 				{Name: "assignee_id", Require: plugin.Optional},
 				{Name: "reviewer_id", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "source_branch", Require: plugin.Optional},
+				{Name: "target_branch", Require: plugin.Optional},
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"?", "?|", "?&"}},
+				{Name: "draft", Require: plugin.Optional},
+				{Name: "milestone_title", Require: plugin.Optional},
+				{Name: "search", Require: plugin.Optional},
+				{Name: "created_at", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "merged_at", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
 			},
 		},
 		Columns: gitlabMergeRequestColumns(),
@@ -58,13 +89,14 @@ func listMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	q := d.EqualsQuals
 
 	if q["project_id"] == nil &&
+		q["group_id"] == nil &&
 		q["assignee_id"] == nil &&
 		q["author_id"] == nil &&
 		q["reviewer_id"] == nil &&
 		isPublicGitLab(d) {
-		plugin.Logger(ctx).Error("listMergeRequests", "Public GitLab requires an '=' qualifier for at least one of the following columns 'reviewer_id', 'assignee_id', 'author_id', 'project_id' - none was provided")
+		plugin.Logger(ctx).Error("listMergeRequests", "Public GitLab requires an '=' qualifier for at least one of the following columns 'reviewer_id', 'assignee_id', 'author_id', 'project_id', 'group_id' - none was provided")
 		return nil, fmt.Errorf("when using the gitlab_merge_request table with GitLab Cloud, `List`" +
-			"call requires an '=' qualifier for one or more of the following columns: 'project_id', 'author_id', 'assignee_id', 'reviewer_id'")
+			"call requires an '=' qualifier for one or more of the following columns: 'project_id', 'group_id', 'author_id', 'assignee_id', 'reviewer_id'")
 	}
 
 	if q["project_id"] != nil {
@@ -72,6 +104,11 @@ func listMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return listProjectMergeRequests(ctx, d, h)
 	}
 
+	if q["group_id"] != nil {
+		plugin.Logger(ctx).Debug("listMergeRequests", "group_id qualifier obtained, re-directing SDK call to ListGroupMergeRequests")
+		return listGroupMergeRequests(ctx, d, h)
+	}
+
 	return listAllMergeRequests(ctx, d, h)
 }
 
@@ -92,49 +129,58 @@ func listProjectMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugi
 		},
 	}
 
-	if q["assignee_id"] != nil {
-		assigneeId := api.AssigneeID(q["assignee_id"].GetInt64Value())
-		opt.AssigneeID = assigneeId
-		plugin.Logger(ctx).Debug("listProjectMergeRequests", "filter[assignee_id]", assigneeId)
-	}
+	filter := parseMergeRequestQualifiers(ctx, d)
+	opt = addOptionalProjectMergeRequestQualifiers(opt, filter)
 
-	if q["author_id"] != nil {
-		authorId := int(q["author_id"].GetInt64Value())
-		opt.AuthorID = &authorId
-		plugin.Logger(ctx).Debug("listProjectMergeRequests", "filter[author_id]", authorId)
+	err = streamMergeRequests(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.MergeRequest, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listProjectMergeRequests", "projectId", projectId, "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.MergeRequests.ListProjectMergeRequests(projectId, opt)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectMergeRequests", "projectId", projectId, "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain merge requests for project_id %d\n%v", projectId, err)
 	}
 
-	if q["reviewer_id"] != nil {
-		reviewerId := api.ReviewerID(q["reviewer_id"].GetInt64Value())
-		opt.ReviewerID = reviewerId
-		plugin.Logger(ctx).Debug("listProjectMergeRequests", "filter[reviewer_id]", reviewerId)
+	plugin.Logger(ctx).Debug("listProjectMergeRequests", "completed successfully")
+	return nil, nil
+}
+
+func listGroupMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listGroupMergeRequests", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupMergeRequests", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	for {
-		plugin.Logger(ctx).Debug("listProjectMergeRequests", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		mergeRequests, response, err := conn.MergeRequests.ListProjectMergeRequests(projectId, opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listProjectMergeRequests", "projectId", projectId, "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain merge requests for project_id %d\n%v", projectId, err)
-		}
+	groupId := int(d.EqualsQuals["group_id"].GetInt64Value())
+	defaultScope := "all"
 
-		for _, mergeRequest := range mergeRequests {
-			d.StreamListItem(ctx, mergeRequest)
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				plugin.Logger(ctx).Debug("listProjectMergeRequests", "completed successfully")
-				return nil, nil
-			}
-		}
+	opt := &api.ListGroupMergeRequestsOptions{
+		Scope: &defaultScope,
+		ListOptions: api.ListOptions{
+			Page:    1,
+			PerPage: 50,
+		},
+	}
 
-		if response.NextPage == 0 {
-			break
-		}
+	filter := parseMergeRequestQualifiers(ctx, d)
+	opt = addOptionalGroupMergeRequestQualifiers(opt, filter)
 
-		opt.Page = response.NextPage
+	err = streamMergeRequests(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.MergeRequest, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listGroupMergeRequests", "groupId", groupId, "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.MergeRequests.ListGroupMergeRequests(groupId, opt)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listGroupMergeRequests", "groupId", groupId, "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain merge requests for group_id %d\n%v", groupId, err)
 	}
 
-	plugin.Logger(ctx).Debug("listProjectMergeRequests", "completed successfully")
+	plugin.Logger(ctx).Debug("listGroupMergeRequests", "completed successfully")
 	return nil, nil
 }
 
@@ -146,7 +192,6 @@ func listAllMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	q := d.EqualsQuals
 	defaultScope := "all"
 
 	opt := &api.ListMergeRequestsOptions{
@@ -157,50 +202,192 @@ func listAllMergeRequests(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		},
 	}
 
+	filter := parseMergeRequestQualifiers(ctx, d)
+	opt = addOptionalMergeRequestQualifiers(opt, filter)
+
+	err = streamMergeRequests(ctx, d, filter.LabelSets, func(labels *api.Labels, page int) ([]*api.MergeRequest, *api.Response, error) {
+		opt.Labels = labels
+		opt.Page = page
+		plugin.Logger(ctx).Debug("listAllMergeRequests", "labels", labels, "page", opt.Page, "perPage", opt.PerPage)
+		return conn.MergeRequests.ListMergeRequests(opt)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("listAllMergeRequests", "page", opt.Page, "error", err)
+		return nil, fmt.Errorf("unable to obtain merge requests\n%v", err)
+	}
+
+	plugin.Logger(ctx).Debug("listAllMergeRequests", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// streamMergeRequests pages through the merge requests returned for each set of labels, the results of multiple label sets (from a ?| qualifier) are de-duplicated.
+func streamMergeRequests(ctx context.Context, d *plugin.QueryData, labelSets []*api.Labels, list func(labels *api.Labels, page int) ([]*api.MergeRequest, *api.Response, error)) error {
+	seen := make(map[int]bool)
+	for _, labels := range labelSets {
+		page := 1
+		for {
+			mergeRequests, resp, err := list(labels, page)
+			if err != nil {
+				return err
+			}
+
+			for _, mergeRequest := range mergeRequests {
+				if seen[mergeRequest.ID] {
+					continue
+				}
+				seen[mergeRequest.ID] = true
+
+				d.StreamListItem(ctx, mergeRequest)
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			page = resp.NextPage
+		}
+	}
+
+	return nil
+}
+
+// parseMergeRequestQualifiers translates the qualifiers of the gitlab_merge_request table into a mergeRequestFilter.
+func parseMergeRequestQualifiers(ctx context.Context, d *plugin.QueryData) *mergeRequestFilter {
+	q := d.EqualsQuals
+	filter := &mergeRequestFilter{}
+
 	if q["assignee_id"] != nil {
 		assigneeId := api.AssigneeID(q["assignee_id"].GetInt64Value())
-		opt.AssigneeID = assigneeId
-		plugin.Logger(ctx).Debug("listAllMergeRequests", "filter[assignee_id]", assigneeId)
+		filter.AssigneeID = assigneeId
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[assignee_id]", assigneeId)
 	}
 
 	if q["author_id"] != nil {
 		authorId := int(q["author_id"].GetInt64Value())
-		opt.AuthorID = &authorId
-		plugin.Logger(ctx).Debug("listAllMergeRequests", "filter[author_id]", authorId)
+		filter.AuthorID = &authorId
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[author_id]", authorId)
 	}
 
 	if q["reviewer_id"] != nil {
 		reviewerId := api.ReviewerID(q["reviewer_id"].GetInt64Value())
-		opt.ReviewerID = reviewerId
-		plugin.Logger(ctx).Debug("listAllMergeRequests", "filter[reviewer_id]", reviewerId)
+		filter.ReviewerID = reviewerId
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[reviewer_id]", reviewerId)
 	}
 
-	for {
-		plugin.Logger(ctx).Debug("listAllMergeRequests", "page", opt.Page, "perPage", opt.PerPage)
-		mergeRequests, response, err := conn.MergeRequests.ListMergeRequests(opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listAllMergeRequests", "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain merge requests\n%v", err)
-		}
+	if q["state"] != nil {
+		state := q["state"].GetStringValue()
+		filter.State = &state
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[state]", state)
+	}
 
-		for _, mergeRequest := range mergeRequests {
-			d.StreamListItem(ctx, mergeRequest)
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				plugin.Logger(ctx).Debug("listAllMergeRequests", "completed successfully")
-				return nil, nil
-			}
-		}
+	if q["source_branch"] != nil {
+		sourceBranch := q["source_branch"].GetStringValue()
+		filter.SourceBranch = &sourceBranch
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[source_branch]", sourceBranch)
+	}
 
-		if response.NextPage == 0 {
-			break
-		}
+	if q["target_branch"] != nil {
+		targetBranch := q["target_branch"].GetStringValue()
+		filter.TargetBranch = &targetBranch
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[target_branch]", targetBranch)
+	}
 
-		opt.Page = response.NextPage
+	if q["draft"] != nil {
+		draft := q["draft"].GetBoolValue()
+		filter.Draft = &draft
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[draft]", draft)
 	}
 
-	plugin.Logger(ctx).Debug("listAllMergeRequests", "completed successfully")
-	return nil, nil
+	if q["milestone_title"] != nil {
+		milestone := q["milestone_title"].GetStringValue()
+		filter.Milestone = &milestone
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[milestone]", milestone)
+	}
+
+	if q["search"] != nil {
+		search := q["search"].GetStringValue()
+		filter.Search = &search
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[search]", search)
+	}
+
+	filter.CreatedAfter, filter.CreatedBefore = timeRangeQualifiers(d, "created_at")
+	filter.UpdatedAfter, filter.UpdatedBefore = timeRangeQualifiers(d, "updated_at")
+	filter.LabelSets = labelSetQualifiers(d, "labels")
+
+	// The API can't filter on merged_at, however a merge request merged after a given time must be in the merged
+	// state and have been updated at or after that time.
+	if mergedAfter, mergedBefore := timeRangeQualifiers(d, "merged_at"); mergedAfter != nil || mergedBefore != nil {
+		if filter.State == nil {
+			state := "merged"
+			filter.State = &state
+		}
+		if mergedAfter != nil && (filter.UpdatedAfter == nil || mergedAfter.After(*filter.UpdatedAfter)) {
+			filter.UpdatedAfter = mergedAfter
+		}
+		plugin.Logger(ctx).Debug("parseMergeRequestQualifiers", "filter[merged_at]", mergedAfter, "state", *filter.State)
+	}
+
+	return filter
+}
+
+func addOptionalProjectMergeRequestQualifiers(opts *api.ListProjectMergeRequestsOptions, f *mergeRequestFilter) *api.ListProjectMergeRequestsOptions {
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.ReviewerID = f.ReviewerID
+	opts.State = f.State
+	opts.SourceBranch = f.SourceBranch
+	opts.TargetBranch = f.TargetBranch
+	opts.Draft = f.Draft
+	opts.Milestone = f.Milestone
+	opts.Search = f.Search
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
+	return opts
+}
+
+func addOptionalGroupMergeRequestQualifiers(opts *api.ListGroupMergeRequestsOptions, f *mergeRequestFilter) *api.ListGroupMergeRequestsOptions {
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.ReviewerID = f.ReviewerID
+	opts.State = f.State
+	opts.SourceBranch = f.SourceBranch
+	opts.TargetBranch = f.TargetBranch
+	opts.Draft = f.Draft
+	opts.Milestone = f.Milestone
+	opts.Search = f.Search
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
+	return opts
+}
+
+func addOptionalMergeRequestQualifiers(opts *api.ListMergeRequestsOptions, f *mergeRequestFilter) *api.ListMergeRequestsOptions {
+	opts.AssigneeID = f.AssigneeID
+	opts.AuthorID = f.AuthorID
+	opts.ReviewerID = f.ReviewerID
+	opts.State = f.State
+	opts.SourceBranch = f.SourceBranch
+	opts.TargetBranch = f.TargetBranch
+	opts.Draft = f.Draft
+	opts.Milestone = f.Milestone
+	opts.Search = f.Search
+	opts.CreatedAfter = f.CreatedAfter
+	opts.CreatedBefore = f.CreatedBefore
+	opts.UpdatedAfter = f.UpdatedAfter
+	opts.UpdatedBefore = f.UpdatedBefore
+
+	return opts
 }
 
 // Transform Functions
@@ -352,6 +539,7 @@ func gitlabMergeRequestColumns() []*plugin.Column {
 			Name:        "draft",
 			Type:        proto.ColumnType_BOOL,
 			Description: "Indicates if the merge request is a draft.",
+			Transform:   transform.FromGo(),
 		},
 		{
 			Name:        "work_in_progress",
@@ -684,5 +872,43 @@ func gitlabMergeRequestColumns() []*plugin.Column {
 			Description: "Indicates if the milestone is expired.",
 			Transform:   transform.FromField("Milestone.Expired"),
 		},
+		// Time Stats
+		{
+			Name:        "time_estimate",
+			Type:        proto.ColumnType_INT,
+			Description: "Time estimated against the merge request (in seconds).",
+			Transform:   transform.FromField("TimeStats.TimeEstimate"),
+		},
+		{
+			Name:        "total_time_spent",
+			Type:        proto.ColumnType_INT,
+			Description: "Total time spent on the merge request (in seconds).",
+			Transform:   transform.FromField("TimeStats.TotalTimeSpent"),
+		},
+		{
+			Name:        "human_time_estimate",
+			Type:        proto.ColumnType_STRING,
+			Description: "Time estimated against the merge request in human readable format (e.g. 1d 4h).",
+			Transform:   transform.FromField("TimeStats.HumanTimeEstimate").NullIfZero(),
+		},
+		{
+			Name:        "human_total_time_spent",
+			Type:        proto.ColumnType_STRING,
+			Description: "Total time spent on the merge request in human readable format (e.g. 1d 4h).",
+			Transform:   transform.FromField("TimeStats.HumanTotalTimeSpent").NullIfZero(),
+		},
+		// Qualifiers
+		{
+			Name:        "search",
+			Type:        proto.ColumnType_STRING,
+			Description: "The search term used to filter the merge requests by title and description.",
+			Transform:   transform.FromQual("search"),
+		},
+		{
+			Name:        "group_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the group to obtain merge requests for (including merge requests of subgroups) - link to `gitlab_group.id`.",
+			Transform:   transform.FromQual("group_id"),
+		},
 	}
 }
@@ -3,12 +3,29 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/http"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
 )
 
+type MergeRequestChange struct {
+	OldPath      string
+	NewPath      string
+	AMode        string
+	BMode        string
+	Diff         string
+	NewFile      bool
+	RenamedFile  bool
+	DeletedFile  bool
+	Language     string
+	HunkCount    int
+	AddedLines   int
+	RemovedLines int
+}
+
 func tableMergeRequestChange() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_merge_request_change",
@@ -34,21 +51,98 @@ func listChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	iid := int(q["iid"].GetInt64Value())
 	projectId := int(q["project_id"].GetInt64Value())
 
-	plugin.Logger(ctx).Debug("listChanges", "projectId", projectId, "iid", iid)
-	mergeRequest, _, err := conn.MergeRequests.GetMergeRequest(projectId, iid, &api.GetMergeRequestsOptions{})
-	if err != nil {
-		plugin.Logger(ctx).Error("listChanges", "projectId", projectId, "iid", iid, "error", err)
-		return nil, fmt.Errorf("unable to obtain changes for merge request %d for project_id %d\n%v", iid, projectId, err)
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 50,
 	}
 
-	for _, change := range mergeRequest.Changes {
-		d.StreamListItem(ctx, change)
+	for {
+		plugin.Logger(ctx).Debug("listChanges", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		changes, resp, err := getMergeRequestChangesPage(conn, projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listChanges", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain changes for merge request %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, change := range changes {
+			diff := parseUnifiedDiff(change.Diff)
+			d.StreamListItem(ctx, &MergeRequestChange{
+				OldPath:      change.OldPath,
+				NewPath:      change.NewPath,
+				AMode:        change.AMode,
+				BMode:        change.BMode,
+				Diff:         change.Diff,
+				NewFile:      change.NewFile,
+				RenamedFile:  change.RenamedFile,
+				DeletedFile:  change.DeletedFile,
+				Language:     languageFromPath(change.NewPath),
+				HunkCount:    len(diff.Hunks),
+				AddedLines:   diff.AddedLines,
+				RemovedLines: diff.RemovedLines,
+			})
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listChanges", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
 	}
 
 	plugin.Logger(ctx).Debug("listChanges", "completed successfully")
 	return nil, nil
 }
 
+// Assist Functions
+
+// getMergeRequestChanges obtains the diffs of all files changed by a merge request, paging through the diffs endpoint
+// so that large merge requests aren't truncated as they are when the changes are embedded in the merge request.
+func getMergeRequestChanges(conn *api.Client, projectId int, iid int) ([]*api.Diff, error) {
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	var changes []*api.Diff
+	for {
+		diffs, resp, err := getMergeRequestChangesPage(conn, projectId, iid, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, diffs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return changes, nil
+}
+
+// getMergeRequestChangesPage obtains a single page of the diffs of the files changed by a merge request.
+func getMergeRequestChangesPage(conn *api.Client, projectId int, iid int, opt *api.ListOptions) ([]*api.Diff, *api.Response, error) {
+	req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/merge_requests/%d/diffs", projectId, iid), opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var diffs []*api.Diff
+	resp, err := conn.Do(req, &diffs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return diffs, resp, nil
+}
+
 // Column Function
 func mergeRequestChangeColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -89,6 +183,29 @@ func mergeRequestChangeColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Description: "The change diff.",
 		},
+		{
+			Name:        "language",
+			Type:        proto.ColumnType_STRING,
+			Description: "The language of the file, derived from the file name or extension.",
+		},
+		{
+			Name:        "hunk_count",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of hunks in the diff.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "added_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines added to the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "removed_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines removed from the file.",
+			Transform:   transform.FromGo(),
+		},
 		{
 			Name:        "new_file",
 			Type:        proto.ColumnType_BOOL,
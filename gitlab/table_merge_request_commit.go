@@ -0,0 +1,158 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableMergeRequestCommit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_merge_request_commit",
+		Description: "Obtain information about the commits contained in a specific merge request from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listMergeRequestCommits,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: mergeRequestCommitColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMergeRequestCommits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMergeRequestCommits", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMergeRequestCommits", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.GetMergeRequestCommitsOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listMergeRequestCommits", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		commits, resp, err := conn.MergeRequests.GetMergeRequestCommits(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMergeRequestCommits", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain commits for merge request %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, commit := range commits {
+			d.StreamListItem(ctx, commit)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listMergeRequestCommits", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listMergeRequestCommits", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func mergeRequestCommitColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID (commit hash) of the commit.",
+		},
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "Internal ID of the merge request to which the commit belongs.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "ID of the project to which the merge request belongs.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "short_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The short ID (short commit hash) of the commit.",
+		},
+		{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the commit.",
+		},
+		{
+			Name:        "message",
+			Type:        proto.ColumnType_STRING,
+			Description: "The commit message.",
+		},
+		{
+			Name:        "author_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the commit author.",
+		},
+		{
+			Name:        "author_email",
+			Type:        proto.ColumnType_STRING,
+			Description: "The email of the commit author.",
+		},
+		{
+			Name:        "authored_date",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the commit was authored.",
+		},
+		{
+			Name:        "committer_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the committer.",
+		},
+		{
+			Name:        "committer_email",
+			Type:        proto.ColumnType_STRING,
+			Description: "The email address of the committer.",
+		},
+		{
+			Name:        "committed_date",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the commit was committed.",
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of the creation of commit.",
+		},
+		{
+			Name:        "parent_ids",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of parent commit hashes.",
+			Transform:   transform.FromField("ParentIDs"),
+		},
+		{
+			Name:        "trailers",
+			Type:        proto.ColumnType_JSON,
+			Description: "The git trailers of the commit (Signed-off-by, Co-authored-by, etc).",
+		},
+		{
+			Name:        "web_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url of the commit.",
+			Transform:   transform.FromField("WebURL"),
+		},
+	}
+}
@@ -0,0 +1,410 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type MergeRequestDiffLine struct {
+	OldPath          string
+	NewPath          string
+	Language         string
+	HunkIndex        int
+	HunkHeader       string
+	HunkOldStart     int
+	HunkOldLines     int
+	HunkNewStart     int
+	HunkNewLines     int
+	LineIndex        int
+	LineType         string
+	OldLine          *int
+	NewLine          *int
+	Content          string
+	FileAddedLines   int
+	FileRemovedLines int
+	MRAddedLines     int
+	MRRemovedLines   int
+}
+
+type parsedDiff struct {
+	Hunks        []*diffHunk
+	AddedLines   int
+	RemovedLines int
+}
+
+type diffHunk struct {
+	Header   string
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []*diffLine
+}
+
+type diffLine struct {
+	Type    string
+	OldLine *int
+	NewLine *int
+	Content string
+}
+
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// languageExtensions maps lower-case file extensions to the language of the file.
+var languageExtensions = map[string]string{
+	".c":          "C",
+	".h":          "C",
+	".cc":         "C++",
+	".cpp":        "C++",
+	".cxx":        "C++",
+	".hpp":        "C++",
+	".cs":         "C#",
+	".css":        "CSS",
+	".scss":       "SCSS",
+	".sass":       "Sass",
+	".less":       "Less",
+	".clj":        "Clojure",
+	".dart":       "Dart",
+	".ex":         "Elixir",
+	".exs":        "Elixir",
+	".erl":        "Erlang",
+	".go":         "Go",
+	".gradle":     "Gradle",
+	".groovy":     "Groovy",
+	".hs":         "Haskell",
+	".html":       "HTML",
+	".htm":        "HTML",
+	".java":       "Java",
+	".js":         "JavaScript",
+	".mjs":        "JavaScript",
+	".cjs":        "JavaScript",
+	".jsx":        "JavaScript",
+	".json":       "JSON",
+	".kt":         "Kotlin",
+	".kts":        "Kotlin",
+	".lua":        "Lua",
+	".md":         "Markdown",
+	".markdown":   "Markdown",
+	".m":          "Objective-C",
+	".pl":         "Perl",
+	".php":        "PHP",
+	".ps1":        "PowerShell",
+	".proto":      "Protocol Buffers",
+	".py":         "Python",
+	".r":          "R",
+	".rb":         "Ruby",
+	".rs":         "Rust",
+	".scala":      "Scala",
+	".sh":         "Shell",
+	".bash":       "Shell",
+	".zsh":        "Shell",
+	".sql":        "SQL",
+	".swift":      "Swift",
+	".tf":         "HCL",
+	".hcl":        "HCL",
+	".spc":        "HCL",
+	".toml":       "TOML",
+	".ts":         "TypeScript",
+	".tsx":        "TypeScript",
+	".vue":        "Vue",
+	".xml":        "XML",
+	".yaml":       "YAML",
+	".yml":        "YAML",
+	".dockerfile": "Dockerfile",
+}
+
+// languageFilenames maps lower-case file names without a meaningful extension to the language of the file.
+var languageFilenames = map[string]string{
+	"dockerfile":    "Dockerfile",
+	"containerfile": "Dockerfile",
+	"makefile":      "Makefile",
+	"gemfile":       "Ruby",
+	"rakefile":      "Ruby",
+	"jenkinsfile":   "Groovy",
+	"go.mod":        "Go Module",
+	"go.sum":        "Go Module",
+}
+
+func tableMergeRequestDiffLine() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_merge_request_diff_line",
+		Description: "Obtain the parsed lines of the diffs of all changes associated with a specific merge request from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listMergeRequestDiffLines,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: mergeRequestDiffLineColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMergeRequestDiffLines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMergeRequestDiffLines", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMergeRequestDiffLines", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+
+	plugin.Logger(ctx).Debug("listMergeRequestDiffLines", "projectId", projectId, "iid", iid)
+	changes, err := getMergeRequestChanges(conn, projectId, iid)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMergeRequestDiffLines", "projectId", projectId, "iid", iid, "error", err)
+		return nil, fmt.Errorf("unable to obtain changes for merge request %d for project_id %d\n%v", iid, projectId, err)
+	}
+
+	// All diffs are parsed up front as each line carries the totals for the whole merge request.
+	diffs := make([]*parsedDiff, len(changes))
+	mrAdded, mrRemoved := 0, 0
+	for i, change := range changes {
+		diffs[i] = parseUnifiedDiff(change.Diff)
+		mrAdded += diffs[i].AddedLines
+		mrRemoved += diffs[i].RemovedLines
+	}
+
+	for i, change := range changes {
+		language := languageFromPath(change.NewPath)
+		lineIndex := 0
+		for hunkIndex, hunk := range diffs[i].Hunks {
+			for _, line := range hunk.Lines {
+				lineIndex++
+				d.StreamListItem(ctx, &MergeRequestDiffLine{
+					OldPath:          change.OldPath,
+					NewPath:          change.NewPath,
+					Language:         language,
+					HunkIndex:        hunkIndex + 1,
+					HunkHeader:       hunk.Header,
+					HunkOldStart:     hunk.OldStart,
+					HunkOldLines:     hunk.OldLines,
+					HunkNewStart:     hunk.NewStart,
+					HunkNewLines:     hunk.NewLines,
+					LineIndex:        lineIndex,
+					LineType:         line.Type,
+					OldLine:          line.OldLine,
+					NewLine:          line.NewLine,
+					Content:          line.Content,
+					FileAddedLines:   diffs[i].AddedLines,
+					FileRemovedLines: diffs[i].RemovedLines,
+					MRAddedLines:     mrAdded,
+					MRRemovedLines:   mrRemoved,
+				})
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					plugin.Logger(ctx).Debug("listMergeRequestDiffLines", "completed successfully")
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listMergeRequestDiffLines", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// parseUnifiedDiff parses the hunks of a unified diff as returned by the GitLab API, numbering each line against the
+// old and new versions of the file.
+func parseUnifiedDiff(diff string) *parsedDiff {
+	output := &parsedDiff{}
+	var hunk *diffHunk
+	oldLine, newLine := 0, 0
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for _, text := range lines {
+		if m := hunkHeaderPattern.FindStringSubmatch(text); m != nil {
+			hunk = &diffHunk{
+				Header:   strings.TrimSpace(m[5]),
+				OldStart: atoiDefault(m[1], 0),
+				OldLines: atoiDefault(m[2], 1),
+				NewStart: atoiDefault(m[3], 0),
+				NewLines: atoiDefault(m[4], 1),
+			}
+			output.Hunks = append(output.Hunks, hunk)
+			oldLine, newLine = hunk.OldStart, hunk.NewStart
+			continue
+		}
+
+		// Anything prior to the first hunk (file headers, binary notices, etc) isn't part of the content.
+		if hunk == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "+"):
+			n := newLine
+			hunk.Lines = append(hunk.Lines, &diffLine{Type: "added", NewLine: &n, Content: text[1:]})
+			output.AddedLines++
+			newLine++
+		case strings.HasPrefix(text, "-"):
+			o := oldLine
+			hunk.Lines = append(hunk.Lines, &diffLine{Type: "removed", OldLine: &o, Content: text[1:]})
+			output.RemovedLines++
+			oldLine++
+		case strings.HasPrefix(text, "\\"):
+			// "\ No newline at end of file" markers don't represent a line of the file.
+			continue
+		default:
+			o, n := oldLine, newLine
+			hunk.Lines = append(hunk.Lines, &diffLine{Type: "context", OldLine: &o, NewLine: &n, Content: strings.TrimPrefix(text, " ")})
+			oldLine++
+			newLine++
+		}
+	}
+
+	return output
+}
+
+// languageFromPath returns the language of a file based upon its name or extension, or an empty string if unknown.
+func languageFromPath(filePath string) string {
+	name := strings.ToLower(path.Base(filePath))
+	if lang, ok := languageFilenames[name]; ok {
+		return lang
+	}
+
+	return languageExtensions[path.Ext(name)]
+}
+
+func atoiDefault(input string, fallback int) int {
+	if input == "" {
+		return fallback
+	}
+
+	i, err := strconv.Atoi(input)
+	if err != nil {
+		return fallback
+	}
+
+	return i
+}
+
+// Column Function
+func mergeRequestDiffLineColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "Internal ID of the merge request to which the change belongs.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "ID of the project to which the merge request belongs.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "old_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "Old path of the file.",
+		},
+		{
+			Name:        "new_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "New path of the file.",
+		},
+		{
+			Name:        "language",
+			Type:        proto.ColumnType_STRING,
+			Description: "The language of the file, derived from the file name or extension.",
+		},
+		{
+			Name:        "hunk_index",
+			Type:        proto.ColumnType_INT,
+			Description: "The position (1-based) of the hunk within the diff of the file.",
+		},
+		{
+			Name:        "hunk_header",
+			Type:        proto.ColumnType_STRING,
+			Description: "The section heading of the hunk (typically the enclosing function or block).",
+		},
+		{
+			Name:        "hunk_old_start",
+			Type:        proto.ColumnType_INT,
+			Description: "The first line of the hunk in the old version of the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "hunk_old_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines of the hunk in the old version of the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "hunk_new_start",
+			Type:        proto.ColumnType_INT,
+			Description: "The first line of the hunk in the new version of the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "hunk_new_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines of the hunk in the new version of the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "line_index",
+			Type:        proto.ColumnType_INT,
+			Description: "The position (1-based) of the line within the diff of the file.",
+		},
+		{
+			Name:        "line_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of the line (added, removed or context).",
+		},
+		{
+			Name:        "old_line",
+			Type:        proto.ColumnType_INT,
+			Description: "The line number in the old version of the file (null for added lines).",
+			Transform:   transform.FromField("OldLine"),
+		},
+		{
+			Name:        "new_line",
+			Type:        proto.ColumnType_INT,
+			Description: "The line number in the new version of the file (null for removed lines).",
+			Transform:   transform.FromField("NewLine"),
+		},
+		{
+			Name:        "content",
+			Type:        proto.ColumnType_STRING,
+			Description: "The content of the line, without the diff prefix.",
+			Transform:   transform.FromField("Content"),
+		},
+		{
+			Name:        "file_added_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The total number of lines added to the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "file_removed_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The total number of lines removed from the file.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "mr_added_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The total number of lines added across all files of the merge request.",
+			Transform:   transform.FromField("MRAddedLines"),
+		},
+		{
+			Name:        "mr_removed_lines",
+			Type:        proto.ColumnType_INT,
+			Description: "The total number of lines removed across all files of the merge request.",
+			Transform:   transform.FromField("MRRemovedLines"),
+		},
+	}
+}
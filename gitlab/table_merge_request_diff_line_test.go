@@ -0,0 +1,153 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testHunk struct {
+	header   string
+	oldStart int
+	oldLines int
+	newStart int
+	newLines int
+	// lines are formatted by formatDiffLine, e.g. "added -/3 text".
+	lines []string
+}
+
+func TestParseUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		diff    string
+		added   int
+		removed int
+		hunks   []testHunk
+	}{
+		{
+			name:  "empty diff",
+			diff:  "",
+			hunks: nil,
+		},
+		{
+			name:  "binary diff",
+			diff:  "Binary files a/logo.png and b/logo.png differ\n",
+			hunks: nil,
+		},
+		{
+			name: "multiple hunks",
+			diff: "--- a/main.go\n+++ b/main.go\n" +
+				"@@ -1,4 +1,4 @@\n package main\n \n-import \"fmt\"\n+import \"log\"\n \n" +
+				"@@ -10,3 +10,4 @@ func main() {\n \tx := 1\n+\ty := 2\n \treturn\n",
+			added:   2,
+			removed: 1,
+			hunks: []testHunk{
+				{
+					oldStart: 1, oldLines: 4, newStart: 1, newLines: 4,
+					lines: []string{
+						"context 1/1 package main",
+						"context 2/2 ",
+						"removed 3/- import \"fmt\"",
+						"added -/3 import \"log\"",
+						"context 4/4 ",
+					},
+				},
+				{
+					header:   "func main() {",
+					oldStart: 10, oldLines: 3, newStart: 10, newLines: 4,
+					lines: []string{
+						"context 10/10 \tx := 1",
+						"added -/11 \ty := 2",
+						"context 11/12 \treturn",
+					},
+				},
+			},
+		},
+		{
+			name:  "added file",
+			diff:  "@@ -0,0 +1,2 @@\n+first\n+second\n",
+			added: 2,
+			hunks: []testHunk{
+				{
+					oldStart: 0, oldLines: 0, newStart: 1, newLines: 2,
+					lines: []string{
+						"added -/1 first",
+						"added -/2 second",
+					},
+				},
+			},
+		},
+		{
+			name:    "deleted file",
+			diff:    "@@ -1,2 +0,0 @@\n-first\n-second\n",
+			removed: 2,
+			hunks: []testHunk{
+				{
+					oldStart: 1, oldLines: 2, newStart: 0, newLines: 0,
+					lines: []string{
+						"removed 1/- first",
+						"removed 2/- second",
+					},
+				},
+			},
+		},
+		{
+			name:    "no newline markers",
+			diff:    "@@ -1 +1,2 @@\n-last\n\\ No newline at end of file\n+last\n+extra\n\\ No newline at end of file\n",
+			added:   2,
+			removed: 1,
+			hunks: []testHunk{
+				{
+					oldStart: 1, oldLines: 1, newStart: 1, newLines: 2,
+					lines: []string{
+						"removed 1/- last",
+						"added -/1 last",
+						"added -/2 extra",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseUnifiedDiff(tt.diff)
+			if got.AddedLines != tt.added || got.RemovedLines != tt.removed {
+				t.Errorf("added/removed = %d/%d, want %d/%d", got.AddedLines, got.RemovedLines, tt.added, tt.removed)
+			}
+			if len(got.Hunks) != len(tt.hunks) {
+				t.Fatalf("got %d hunks, want %d", len(got.Hunks), len(tt.hunks))
+			}
+
+			for i, want := range tt.hunks {
+				hunk := got.Hunks[i]
+				if hunk.Header != want.header {
+					t.Errorf("hunk %d header = %q, want %q", i, hunk.Header, want.header)
+				}
+				if hunk.OldStart != want.oldStart || hunk.OldLines != want.oldLines || hunk.NewStart != want.newStart || hunk.NewLines != want.newLines {
+					t.Errorf("hunk %d range = -%d,%d +%d,%d, want -%d,%d +%d,%d", i,
+						hunk.OldStart, hunk.OldLines, hunk.NewStart, hunk.NewLines,
+						want.oldStart, want.oldLines, want.newStart, want.newLines)
+				}
+				if len(hunk.Lines) != len(want.lines) {
+					t.Fatalf("hunk %d has %d lines, want %d", i, len(hunk.Lines), len(want.lines))
+				}
+				for j, line := range hunk.Lines {
+					if s := formatDiffLine(line); s != want.lines[j] {
+						t.Errorf("hunk %d line %d = %q, want %q", i, j, s, want.lines[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func formatDiffLine(line *diffLine) string {
+	number := func(n *int) string {
+		if n == nil {
+			return "-"
+		}
+		return fmt.Sprint(*n)
+	}
+
+	return fmt.Sprintf("%s %s/%s %s", line.Type, number(line.OldLine), number(line.NewLine), line.Content)
+}
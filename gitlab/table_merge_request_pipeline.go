@@ -0,0 +1,95 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableMergeRequestPipeline() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_merge_request_pipeline",
+		Description: "Obtain information about the pipelines which ran for a specific merge request from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listMergeRequestPipelines,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: mergeRequestPipelineColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMergeRequestPipelines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMergeRequestPipelines", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMergeRequestPipelines", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	// The SDK doesn't expose pagination options for merge request pipelines, so the request is built directly.
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listMergeRequestPipelines", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/merge_requests/%d/pipelines", projectId, iid), opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMergeRequestPipelines", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to create request for pipelines of merge request %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		var pipelines []*api.PipelineInfo
+		resp, err := conn.Do(req, &pipelines)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMergeRequestPipelines", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain pipelines for merge request %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, pipeline := range pipelines {
+			d.StreamListItem(ctx, pipeline)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listMergeRequestPipelines", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listMergeRequestPipelines", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func mergeRequestPipelineColumns() []*plugin.Column {
+	return append(projectPipelineColumns(),
+		&plugin.Column{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "Internal ID of the merge request for which the pipeline ran.",
+			Transform:   transform.FromQual("iid"),
+		},
+		&plugin.Column{
+			Name:        "pipeline_iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The internal ID of the pipeline within the project.",
+			Transform:   transform.FromField("IID"),
+		},
+	)
+}
@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableMergeRequestVersion() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_merge_request_version",
+		Description: "Obtain information about the diff versions of a specific merge request from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate:    listMergeRequestVersions,
+			KeyColumns: plugin.AllColumns([]string{"iid", "project_id"}),
+		},
+		Columns: mergeRequestVersionColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMergeRequestVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMergeRequestVersions", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMergeRequestVersions", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	iid := int(q["iid"].GetInt64Value())
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.GetMergeRequestDiffVersionsOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listMergeRequestVersions", "projectId", projectId, "iid", iid, "page", opt.Page, "perPage", opt.PerPage)
+		versions, resp, err := conn.MergeRequests.GetMergeRequestDiffVersions(projectId, iid, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMergeRequestVersions", "projectId", projectId, "iid", iid, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain versions for merge request %d for project_id %d\n%v", iid, projectId, err)
+		}
+
+		for _, version := range versions {
+			d.StreamListItem(ctx, version)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listMergeRequestVersions", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listMergeRequestVersions", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func mergeRequestVersionColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the diff version.",
+		},
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "Internal ID of the merge request to which the version belongs.",
+			Transform:   transform.FromQual("iid"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "ID of the project to which the merge request belongs.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "merge_request_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the merge request to which the version belongs - link to `gitlab_merge_request.id`.",
+		},
+		{
+			Name:        "head_commit_sha",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA of the head (latest source branch) commit of the version.",
+			Transform:   transform.FromField("HeadCommitSHA"),
+		},
+		{
+			Name:        "base_commit_sha",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA of the merge base commit of the version.",
+			Transform:   transform.FromField("BaseCommitSHA"),
+		},
+		{
+			Name:        "start_commit_sha",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA of the target branch commit the version was created against.",
+			Transform:   transform.FromField("StartCommitSHA"),
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state of the diff version (collected, overflow, empty, etc).",
+		},
+		{
+			Name:        "real_size",
+			Type:        proto.ColumnType_STRING,
+			Description: "The number of files changed in the version (may be suffixed with + if the diff overflowed).",
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the version was created.",
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableMyEmail() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_my_email",
+		Description: "Obtain information about the email addresses of the authenticated user within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate: listMyEmails,
+		},
+		Columns: emailColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMyEmails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMyEmails", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMyEmails", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	emails, _, err := conn.Users.ListEmails()
+	if err != nil {
+		plugin.Logger(ctx).Error("listMyEmails", "error", err)
+		return nil, fmt.Errorf("unable to obtain my emails\n%v", err)
+	}
+
+	for _, email := range emails {
+		d.StreamListItem(ctx, email)
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listMyEmails", "completed successfully")
+	return nil, nil
+}
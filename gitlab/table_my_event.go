@@ -3,8 +3,6 @@ package gitlab
 import (
 	"context"
 	"fmt"
-	"strings"
-	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	api "github.com/xanzy/go-gitlab"
@@ -15,24 +13,8 @@ func tableMyEvents() *plugin.Table {
 		Name:        "gitlab_my_event",
 		Description: "Obtain information about my events.",
 		List: &plugin.ListConfig{
-			KeyColumns: []*plugin.KeyColumn{
-				{
-					Name:      "created_at",
-					Require:   plugin.Optional,
-					Operators: []string{">", ">=", "=", "<", "<="},
-				},
-				{
-					Name:      "target_type",
-					Require:   plugin.Optional,
-					Operators: []string{"="},
-				},
-				{
-					Name:      "action_name",
-					Require:   plugin.Optional,
-					Operators: []string{"="},
-				},
-			},
-			Hydrate: listMyEvents,
+			KeyColumns: eventKeyColumns(),
+			Hydrate:    listMyEvents,
 		},
 		Columns: eventColumns(),
 	}
@@ -52,42 +34,7 @@ func listMyEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		PerPage: 50,
 	}}
 
-	if d.Quals["created_at"] != nil {
-		for _, q := range d.Quals["created_at"].Quals {
-			givenTime := q.Value.GetTimestampValue().AsTime()
-			beforeTime := givenTime.Add(time.Duration(-1) * time.Second)
-			afterTime := givenTime.Add(time.Second * 1)
-			givenISOTime := api.ISOTime(givenTime)
-			beforeISOTime := api.ISOTime(beforeTime)
-			afterISOTime := api.ISOTime(afterTime)
-
-			switch q.Operator {
-			case ">":
-				opt.After = &afterISOTime
-			case ">=":
-				opt.After = &givenISOTime
-			case "=":
-				opt.After = &beforeISOTime
-				opt.Before = &afterISOTime
-			case "<=":
-				opt.Before = &givenISOTime
-			case "<":
-				opt.Before = &beforeISOTime
-			}
-		}
-	}
-
-	if d.Quals["target_type"] != nil {
-		targetType := api.EventTargetTypeValue(
-			strings.ToLower(d.EqualsQuals["target_type"].GetStringValue()))
-		opt.TargetType = &targetType
-	}
-
-	if d.Quals["action_name"] != nil {
-		action := api.EventTypeValue(
-			strings.ToLower(d.EqualsQuals["action_name"].GetStringValue()))
-		opt.Action = &action
-	}
+	addOptionalEventQualifiers(opt, d)
 
 	for {
 		plugin.Logger(ctx).Debug("listMyEvents", "page", opt.Page, "perPage", opt.PerPage)
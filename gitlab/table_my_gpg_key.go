@@ -0,0 +1,46 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableMyGPGKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_my_gpg_key",
+		Description: "Obtain information about the GPG keys of the authenticated user within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate: listMyGPGKeys,
+		},
+		Columns: gpgKeyColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMyGPGKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMyGPGKeys", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMyGPGKeys", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	keys, _, err := conn.Users.ListGPGKeys()
+	if err != nil {
+		plugin.Logger(ctx).Error("listMyGPGKeys", "error", err)
+		return nil, fmt.Errorf("unable to obtain my gpg keys\n%v", err)
+	}
+
+	for _, key := range keys {
+		d.StreamListItem(ctx, parseGPGKey(ctx, key))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listMyGPGKeys", "completed successfully")
+	return nil, nil
+}
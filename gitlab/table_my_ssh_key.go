@@ -0,0 +1,62 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableMySSHKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_my_ssh_key",
+		Description: "Obtain information about the SSH keys of the authenticated user within the GitLab instance.",
+		List: &plugin.ListConfig{
+			Hydrate: listMySSHKeys,
+		},
+		Columns: sshKeyColumns(),
+	}
+}
+
+// Hydrate Functions
+func listMySSHKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listMySSHKeys", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMySSHKeys", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	opt := &api.ListSSHKeysOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listMySSHKeys", "page", opt.Page, "perPage", opt.PerPage)
+		keys, resp, err := conn.Users.ListSSHKeys(opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMySSHKeys", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain my ssh keys\n%v", err)
+		}
+
+		for _, key := range keys {
+			d.StreamListItem(ctx, parseSSHKey(ctx, key))
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listMySSHKeys", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listMySSHKeys", "completed successfully")
+	return nil, nil
+}
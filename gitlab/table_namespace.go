@@ -0,0 +1,219 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableNamespace() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_namespace",
+		Description: "Obtain information about the user and group namespaces within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "search",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "owned_only",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listNamespaces,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getNamespace,
+		},
+		Columns: namespaceColumns(),
+	}
+}
+
+// Hydrate Functions
+func listNamespaces(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listNamespaces", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listNamespaces", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	opt := &api.ListNamespacesOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	if q["search"] != nil {
+		search := q["search"].GetStringValue()
+		opt.Search = &search
+		plugin.Logger(ctx).Debug("listNamespaces", "filter[search]", search)
+	}
+
+	if q["owned_only"] != nil {
+		ownedOnly := q["owned_only"].GetBoolValue()
+		opt.OwnedOnly = &ownedOnly
+		plugin.Logger(ctx).Debug("listNamespaces", "filter[owned_only]", ownedOnly)
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listNamespaces", "page", opt.Page, "perPage", opt.PerPage)
+		namespaces, resp, err := conn.Namespaces.ListNamespaces(opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listNamespaces", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain namespaces\n%v", err)
+		}
+
+		for _, namespace := range namespaces {
+			d.StreamListItem(ctx, namespace)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listNamespaces", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listNamespaces", "completed successfully")
+	return nil, nil
+}
+
+func getNamespace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("getNamespace", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getNamespace", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	namespaceId := int(d.EqualsQuals["id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("getNamespace", "namespaceId", namespaceId)
+
+	namespace, _, err := conn.Namespaces.GetNamespace(namespaceId)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			plugin.Logger(ctx).Warn("getNamespace", "namespaceId", namespaceId, "no namespace was found, returning empty result set")
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("getNamespace", "namespaceId", namespaceId, "error", err)
+		return nil, fmt.Errorf("unable to obtain namespace with id %d\n%v", namespaceId, err)
+	}
+
+	plugin.Logger(ctx).Debug("getNamespace", "completed successfully")
+	return namespace, nil
+}
+
+// Column Function
+func namespaceColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the namespace.",
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the namespace.",
+		},
+		{
+			Name:        "path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The path of the namespace.",
+		},
+		{
+			Name:        "kind",
+			Type:        proto.ColumnType_STRING,
+			Description: "The kind of namespace (user or group).",
+		},
+		{
+			Name:        "full_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full path of the namespace, including any parent groups.",
+		},
+		{
+			Name:        "parent_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the parent namespace (null for top-level groups and user namespaces).",
+			Transform:   transform.FromField("ParentID"),
+		},
+		{
+			Name:        "avatar_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url of the namespace avatar.",
+			Transform:   transform.FromField("AvatarURL"),
+		},
+		{
+			Name:        "web_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url of the namespace.",
+			Transform:   transform.FromField("WebURL"),
+		},
+		{
+			Name:        "members_count_with_descendants",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of members of the namespace, including members of descendant groups.",
+		},
+		{
+			Name:        "billable_members_count",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of billable members of the namespace.",
+		},
+		{
+			Name:        "plan",
+			Type:        proto.ColumnType_STRING,
+			Description: "The subscription plan of the namespace.",
+		},
+		{
+			Name:        "trial",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the namespace is currently on a trial.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "trial_ends_on",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The date the trial of the namespace ends.",
+			Transform:   transform.FromField("TrialEndsOn").NullIfZero().Transform(isoTimeTransform),
+		},
+		{
+			Name:        "max_seats_used",
+			Type:        proto.ColumnType_INT,
+			Description: "The highest number of seats used by the namespace.",
+			Transform:   transform.FromField("MaxSeatsUsed"),
+		},
+		{
+			Name:        "seats_in_use",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of seats currently in use by the namespace.",
+			Transform:   transform.FromField("SeatsInUse"),
+		},
+		{
+			Name:        "search",
+			Type:        proto.ColumnType_STRING,
+			Description: "The search term used to filter the namespaces by name or path.",
+			Transform:   transform.FromQual("search"),
+		},
+		{
+			Name:        "owned_only",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if only namespaces owned by the authenticated user were requested.",
+			Transform:   transform.FromQual("owned_only"),
+		},
+	}
+}
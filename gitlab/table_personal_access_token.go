@@ -0,0 +1,209 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// listPersonalAccessTokensOptions extends the SDK options with the filters the API supports but the SDK doesn't expose.
+type listPersonalAccessTokensOptions struct {
+	api.ListOptions
+	UserID         *int       `url:"user_id,omitempty" json:"user_id,omitempty"`
+	State          *string    `url:"state,omitempty" json:"state,omitempty"`
+	Revoked        *bool      `url:"revoked,omitempty" json:"revoked,omitempty"`
+	LastUsedBefore *time.Time `url:"last_used_before,omitempty" json:"last_used_before,omitempty"`
+	LastUsedAfter  *time.Time `url:"last_used_after,omitempty" json:"last_used_after,omitempty"`
+}
+
+func tablePersonalAccessToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_personal_access_token",
+		Description: "Obtain information about personal access tokens within the GitLab instance (all tokens requires admin).",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "state",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "revoked",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "last_used_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+			Hydrate: listPersonalAccessTokens,
+		},
+		Columns: personalAccessTokenColumns(),
+	}
+}
+
+// Hydrate Functions
+func listPersonalAccessTokens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listPersonalAccessTokens", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listPersonalAccessTokens", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	opt := &listPersonalAccessTokensOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	if q["user_id"] != nil {
+		userId := int(q["user_id"].GetInt64Value())
+		opt.UserID = &userId
+		plugin.Logger(ctx).Debug("listPersonalAccessTokens", "filter[user_id]", userId)
+	}
+
+	if q["state"] != nil {
+		state := q["state"].GetStringValue()
+		opt.State = &state
+		plugin.Logger(ctx).Debug("listPersonalAccessTokens", "filter[state]", state)
+	}
+
+	if q["revoked"] != nil {
+		revoked := q["revoked"].GetBoolValue()
+		opt.Revoked = &revoked
+		plugin.Logger(ctx).Debug("listPersonalAccessTokens", "filter[revoked]", revoked)
+	}
+
+	if d.Quals["last_used_at"] != nil {
+		for _, qual := range d.Quals["last_used_at"].Quals {
+			givenTime := qual.Value.GetTimestampValue().AsTime()
+
+			switch qual.Operator {
+			case ">", ">=":
+				opt.LastUsedAfter = &givenTime
+			case "<", "<=":
+				opt.LastUsedBefore = &givenTime
+			}
+		}
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listPersonalAccessTokens", "page", opt.Page, "perPage", opt.PerPage)
+		req, err := conn.NewRequest(http.MethodGet, "personal_access_tokens", opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listPersonalAccessTokens", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to create request for personal access tokens\n%v", err)
+		}
+
+		var tokens []*api.PersonalAccessToken
+		resp, err := conn.Do(req, &tokens)
+		if err != nil {
+			plugin.Logger(ctx).Error("listPersonalAccessTokens", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain personal access tokens\n%v", err)
+		}
+
+		for _, token := range tokens {
+			d.StreamListItem(ctx, token)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listPersonalAccessTokens", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listPersonalAccessTokens", "completed successfully")
+	return nil, nil
+}
+
+// Transform Functions
+func tokenStateTransform(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	if input.Value == nil {
+		return nil, nil
+	}
+
+	if input.Value.(bool) {
+		return "active", nil
+	}
+
+	return "inactive", nil
+}
+
+// Column Function
+func personalAccessTokenColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the personal access token.",
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the personal access token.",
+		},
+		{
+			Name:        "user_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user who owns the token - link to `gitlab_user.id`.",
+		},
+		{
+			Name:        "scopes",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of scopes granted to the personal access token.",
+		},
+		{
+			Name:        "state",
+			Type:        proto.ColumnType_STRING,
+			Description: "The state of the personal access token (active/inactive).",
+			Transform:   transform.FromField("Active").Transform(tokenStateTransform),
+		},
+		{
+			Name:        "active",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the personal access token is active.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "revoked",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the personal access token has been revoked.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the personal access token was created.",
+		},
+		{
+			Name:        "last_used_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the personal access token was last used.",
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the personal access token expires.",
+			Transform:   transform.FromField("ExpiresAt").NullIfZero().Transform(isoTimeTransform),
+		},
+	}
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -644,7 +645,7 @@ func projectColumns() []*plugin.Column {
 		{
 			Name:        "ci_forward_deployment_enabled",
 			Type:        proto.ColumnType_BOOL,
-			Description: "Indicates if ci forward deployments are enabled.",
+			Description: "Indicates if ci forward deployments are enabled (only returned to users who can administer the project, false otherwise).",
 			Transform:   transform.FromField("CIForwardDeploymentEnabled"),
 		},
 		{
@@ -656,8 +657,20 @@ func projectColumns() []*plugin.Column {
 		{
 			Name:        "ci_separated_caches",
 			Type:        proto.ColumnType_BOOL,
-			Description: "Indicates if the CI uses separate caches.",
+			Description: "Indicates if the CI uses separate caches (only returned to users who can administer the project, false otherwise).",
 			Transform:   transform.FromField("CISeperateCache"),
 		},
+		{
+			Name:        "keep_latest_artifact",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the artifacts of the latest successful pipeline of each ref are kept regardless of expiry (only returned to users who can administer the project, false otherwise).",
+			Transform:   transform.FromField("KeepLatestArtifact"),
+		},
+		{
+			Name:        "restrict_user_defined_variables",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if only users with the maintainer role can pass variables when triggering pipelines (only returned to users who can administer the project, false otherwise).",
+			Transform:   transform.FromField("RestrictUserDefinedVariables"),
+		},
 	}
 }
@@ -0,0 +1,141 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableProjectAccessToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_access_token",
+		Description: "Obtain information about access tokens for a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectAccessTokens,
+		},
+		Columns: projectAccessTokenColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectAccessTokens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectAccessTokens", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectAccessTokens", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListProjectAccessTokensOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectAccessTokens", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		tokens, resp, err := conn.ProjectAccessTokens.ListProjectAccessTokens(projectId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectAccessTokens", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain access tokens for project_id %d\n%v", projectId, err)
+		}
+
+		for _, token := range tokens {
+			d.StreamListItem(ctx, token)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectAccessTokens", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectAccessTokens", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectAccessTokenColumns() []*plugin.Column {
+	return append(accessTokenColumns(), &plugin.Column{
+		Name:        "project_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the project the token belongs to - link to `gitlab_project.id`.",
+		Transform:   transform.FromQual("project_id"),
+	})
+}
+
+// accessTokenColumns are the columns shared by project & group access tokens.
+func accessTokenColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the access token.",
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the access token.",
+		},
+		{
+			Name:        "user_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the bot user associated with the token - link to `gitlab_user.id`.",
+		},
+		{
+			Name:        "scopes",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of scopes granted to the access token.",
+		},
+		{
+			Name:        "access_level",
+			Type:        proto.ColumnType_INT,
+			Description: "The numeric value of the access level granted to the access token.",
+		},
+		{
+			Name:        "access_level_description",
+			Type:        proto.ColumnType_STRING,
+			Description: "The access level granted to the access token.",
+			Transform:   transform.FromField("AccessLevel").Transform(accessLevelTransform),
+		},
+		{
+			Name:        "active",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the access token is active.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "revoked",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the access token has been revoked.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the access token was created.",
+		},
+		{
+			Name:        "last_used_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the access token was last used.",
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the access token expires.",
+			Transform:   transform.FromField("ExpiresAt").NullIfZero().Transform(isoTimeTransform),
+		},
+	}
+}
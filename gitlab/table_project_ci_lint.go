@@ -0,0 +1,149 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// ProjectCILint is the result of linting the CI configuration of a project, the SDK result doesn't include the jobs.
+type ProjectCILint struct {
+	Valid      bool                     `json:"valid"`
+	Errors     []string                 `json:"errors"`
+	Warnings   []string                 `json:"warnings"`
+	MergedYaml string                   `json:"merged_yaml"`
+	Jobs       []map[string]interface{} `json:"jobs"`
+	DryRun     bool                     `json:"-"`
+}
+
+func tableProjectCILint() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_ci_lint",
+		Description: "Obtain the result of validating the CI configuration of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "ref",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "dry_run",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listProjectCILint,
+		},
+		Columns: projectCILintColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectCILint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectCILint", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectCILint", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	projectId := int(q["project_id"].GetInt64Value())
+	opt := &api.ProjectLintOptions{IncludeJobs: api.Bool(true)}
+
+	if q["dry_run"] != nil {
+		opt.DryRun = api.Bool(q["dry_run"].GetBoolValue())
+	}
+
+	// The ref is only used by the API when simulating pipeline creation, so it implies a dry run.
+	if q["ref"] != nil {
+		if opt.DryRun != nil && !*opt.DryRun {
+			plugin.Logger(ctx).Error("listProjectCILint", "projectId", projectId, "ref and dry_run = false were both specified")
+			return nil, fmt.Errorf("dry_run must not be false when a ref is specified, as validating a ref simulates pipeline creation")
+		}
+		opt.Ref = api.String(q["ref"].GetStringValue())
+		opt.DryRun = api.Bool(true)
+	}
+
+	plugin.Logger(ctx).Debug("listProjectCILint", "projectId", projectId, "ref", q["ref"].GetStringValue())
+	req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/ci/lint", projectId), opt, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectCILint", "projectId", projectId, "error", err)
+		return nil, fmt.Errorf("unable to obtain ci lint result for project_id %d\n%v", projectId, err)
+	}
+
+	var lint ProjectCILint
+	_, err = conn.Do(req, &lint)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectCILint", "projectId", projectId, "error", err)
+		return nil, fmt.Errorf("unable to obtain ci lint result for project_id %d\n%v", projectId, err)
+	}
+
+	lint.DryRun = opt.DryRun != nil && *opt.DryRun
+	d.StreamListItem(ctx, &lint)
+
+	plugin.Logger(ctx).Debug("listProjectCILint", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectCILintColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the CI configuration belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "ref",
+			Type:        proto.ColumnType_STRING,
+			Description: "The branch or tag the CI configuration was validated for, defaults to the default branch of the project.",
+			Transform:   transform.FromQual("ref"),
+		},
+		{
+			Name:        "dry_run",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if pipeline creation was simulated, which also validates rules and needs (implied when a ref is specified).",
+			Transform:   transform.FromField("DryRun"),
+		},
+		{
+			Name:        "valid",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the CI configuration is valid.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "errors",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the errors in the CI configuration.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "warnings",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the warnings (e.g. of deprecated keywords) in the CI configuration.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "merged_yaml",
+			Type:        proto.ColumnType_STRING,
+			Description: "The CI configuration with all includes expanded.",
+		},
+		{
+			Name:        "jobs",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of the jobs defined by the CI configuration, with their stage, scripts, rules and other settings.",
+		},
+	}
+}
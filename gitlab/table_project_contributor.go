@@ -0,0 +1,108 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableProjectContributor() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_contributor",
+		Description: "Obtain information about the contributors to the repository of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectContributors,
+		},
+		Columns: projectContributorColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectContributors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectContributors", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectContributors", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListContributorsOptions{
+		ListOptions: api.ListOptions{
+			Page:    1,
+			PerPage: 50,
+		},
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectContributors", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		contributors, resp, err := conn.Repositories.Contributors(projectId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectContributors", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain contributors for project_id %d\n%v", projectId, err)
+		}
+
+		for _, contributor := range contributors {
+			d.StreamListItem(ctx, contributor)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectContributors", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectContributors", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectContributorColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the contributor (as recorded on the commits).",
+		},
+		{
+			Name:        "email",
+			Type:        proto.ColumnType_STRING,
+			Description: "The email address of the contributor (as recorded on the commits).",
+		},
+		{
+			Name:        "commits",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of commits made by the contributor on the default branch.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "additions",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines added by the contributor (newer versions of GitLab always return 0).",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "deletions",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of lines deleted by the contributor (newer versions of GitLab always return 0).",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project this repository belongs to - link `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+	}
+}
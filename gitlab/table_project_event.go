@@ -0,0 +1,81 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableProjectEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_event",
+		Description: "Obtain information about the events of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: append([]*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+			}, eventKeyColumns()...),
+			Hydrate: listProjectEvents,
+		},
+		Columns: eventColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectEvents", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectEvents", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListContributionEventsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	addOptionalEventQualifiers(opt, d)
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectEvents", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		// The SDK's ProjectEvent type differs from the ContributionEvent used by the other event tables (string dates etc),
+		// the payload is the same so the request is made directly in order to share eventColumns.
+		req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/events", projectId), opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectEvents", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to create request for events for project_id %d\n%v", projectId, err)
+		}
+
+		var events []*api.ContributionEvent
+		resp, err := conn.Do(req, &events)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectEvents", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain events for project_id %d\n%v", projectId, err)
+		}
+
+		for _, event := range events {
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectEvents", "completed successfully")
+	return nil, nil
+}
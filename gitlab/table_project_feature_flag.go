@@ -0,0 +1,212 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// ProjectFeatureFlagStrategyScope is a feature flag flattened to a single strategy and environment scope of that strategy.
+type ProjectFeatureFlagStrategyScope struct {
+	Flag     *api.ProjectFeatureFlag
+	Strategy *api.ProjectFeatureFlagStrategy
+	Scope    *api.ProjectFeatureFlagScope
+}
+
+func tableProjectFeatureFlag() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_feature_flag",
+		Description: "Obtain information about the feature flags of a specific project within the GitLab instance, with a row per strategy and environment scope.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "active",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listProjectFeatureFlags,
+		},
+		Columns: projectFeatureFlagColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectFeatureFlags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectFeatureFlags", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectFeatureFlags", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListProjectFeatureFlagOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	if d.EqualsQuals["active"] != nil {
+		if d.EqualsQuals["active"].GetBoolValue() {
+			opt.Scope = api.String("enabled")
+		} else {
+			opt.Scope = api.String("disabled")
+		}
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectFeatureFlags", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		flags, resp, err := conn.ProjectFeatureFlags.ListProjectFeatureFlags(projectId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectFeatureFlags", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain feature flags for project_id %d\n%v", projectId, err)
+		}
+
+		for _, flag := range flags {
+			for _, row := range flattenProjectFeatureFlag(flag) {
+				d.StreamListItem(ctx, row)
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					plugin.Logger(ctx).Debug("listProjectFeatureFlags", "completed successfully")
+					return nil, nil
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectFeatureFlags", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// flattenProjectFeatureFlag returns a row per strategy and scope, retaining flags and strategies without any as a single row.
+func flattenProjectFeatureFlag(flag *api.ProjectFeatureFlag) []*ProjectFeatureFlagStrategyScope {
+	if len(flag.Strategies) == 0 {
+		return []*ProjectFeatureFlagStrategyScope{{Flag: flag}}
+	}
+
+	var rows []*ProjectFeatureFlagStrategyScope
+	for _, strategy := range flag.Strategies {
+		if len(strategy.Scopes) == 0 {
+			rows = append(rows, &ProjectFeatureFlagStrategyScope{Flag: flag, Strategy: strategy})
+			continue
+		}
+
+		for _, scope := range strategy.Scopes {
+			rows = append(rows, &ProjectFeatureFlagStrategyScope{Flag: flag, Strategy: strategy, Scope: scope})
+		}
+	}
+
+	return rows
+}
+
+// Column Function
+func projectFeatureFlagColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the feature flag belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the feature flag.",
+			Transform:   transform.FromField("Flag.Name"),
+		},
+		{
+			Name:        "description",
+			Type:        proto.ColumnType_STRING,
+			Description: "The description of the feature flag.",
+			Transform:   transform.FromField("Flag.Description").NullIfZero(),
+		},
+		{
+			Name:        "active",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the feature flag is active.",
+			Transform:   transform.FromField("Flag.Active"),
+		},
+		{
+			Name:        "version",
+			Type:        proto.ColumnType_STRING,
+			Description: "The version of the feature flag (e.g. new_version_flag).",
+			Transform:   transform.FromField("Flag.Version"),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the feature flag was created.",
+			Transform:   transform.FromField("Flag.CreatedAt"),
+		},
+		{
+			Name:        "updated_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the feature flag was last updated.",
+			Transform:   transform.FromField("Flag.UpdatedAt"),
+		},
+		{
+			Name:        "strategy_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the strategy, null if the feature flag has no strategies.",
+			Transform:   transform.FromField("Strategy.ID"),
+		},
+		{
+			Name:        "strategy_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the strategy (e.g. default, gradualRolloutUserId, userWithId, gitlabUserList or flexibleRollout).",
+			Transform:   transform.FromField("Strategy.Name"),
+		},
+		{
+			Name:        "strategy_group_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The group ID parameter of the strategy, used for percentage rollouts.",
+			Transform:   transform.FromField("Strategy.Parameters.GroupID").NullIfZero(),
+		},
+		{
+			Name:        "strategy_percentage",
+			Type:        proto.ColumnType_STRING,
+			Description: "The percentage parameter of the strategy, used for percentage rollouts.",
+			Transform:   transform.FromField("Strategy.Parameters.Percentage").NullIfZero(),
+		},
+		{
+			Name:        "strategy_user_ids",
+			Type:        proto.ColumnType_STRING,
+			Description: "The comma separated user IDs parameter of the strategy, used for the userWithId strategy.",
+			Transform:   transform.FromField("Strategy.Parameters.UserIDs").NullIfZero(),
+		},
+		{
+			Name:        "scope_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the environment scope of the strategy.",
+			Transform:   transform.FromField("Scope.ID"),
+		},
+		{
+			Name:        "environment_scope",
+			Type:        proto.ColumnType_STRING,
+			Description: "The environment scope of the strategy (e.g. production or * for all environments).",
+			Transform:   transform.FromField("Scope.EnvironmentScope"),
+		},
+		{
+			Name:        "strategies",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of all strategies of the feature flag, including their parameters and scopes.",
+			Transform:   transform.FromField("Flag.Strategies"),
+		},
+	}
+}
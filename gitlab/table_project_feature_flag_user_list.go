@@ -0,0 +1,136 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// FeatureFlagUserList is a user list of the feature flags of a project, these aren't supported by the SDK.
+type FeatureFlagUserList struct {
+	ID        int        `json:"id"`
+	IID       int        `json:"iid"`
+	Name      string     `json:"name"`
+	UserXIDs  string     `json:"user_xids"`
+	Path      string     `json:"path"`
+	EditPath  string     `json:"edit_path"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func tableProjectFeatureFlagUserList() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_feature_flag_user_list",
+		Description: "Obtain information about the user lists used by the feature flags of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectFeatureFlagUserLists,
+		},
+		Columns: projectFeatureFlagUserListColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectFeatureFlagUserLists(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectFeatureFlagUserLists", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectFeatureFlagUserLists", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectFeatureFlagUserLists", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/feature_flags_user_lists", projectId), opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectFeatureFlagUserLists", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain feature flag user lists for project_id %d\n%v", projectId, err)
+		}
+
+		var userLists []*FeatureFlagUserList
+		resp, err := conn.Do(req, &userLists)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectFeatureFlagUserLists", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain feature flag user lists for project_id %d\n%v", projectId, err)
+		}
+
+		for _, userList := range userLists {
+			d.StreamListItem(ctx, userList)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectFeatureFlagUserLists", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectFeatureFlagUserLists", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectFeatureFlagUserListColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user list.",
+		},
+		{
+			Name:        "iid",
+			Type:        proto.ColumnType_INT,
+			Description: "The instance ID of the user list.",
+			Transform:   transform.FromField("IID"),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the user list belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the user list.",
+		},
+		{
+			Name:        "user_xids",
+			Type:        proto.ColumnType_STRING,
+			Description: "The comma separated external user IDs in the user list.",
+			Transform:   transform.FromField("UserXIDs"),
+		},
+		{
+			Name:        "path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The path of the user list in the web interface.",
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the user list was created.",
+		},
+		{
+			Name:        "updated_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the user list was last updated.",
+		},
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -17,8 +21,51 @@ func tableProjectJob() *plugin.Table {
 		Name:        "gitlab_project_job",
 		Description: "Obtain information about jobs for a specific project within the GitLab instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listProjectJobs,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "pipeline_id",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "status",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "include_retried",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "trace_tail_bytes",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listProjectJobs,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "trace_tail_bytes",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: getProjectJob,
 		},
 		Columns: projectJobColumns(),
 	}
@@ -34,17 +81,30 @@ func listProjectJobs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	}
 
 	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
-	opt := &api.ListJobsOptions{ListOptions: api.ListOptions{
-		Page:    1,
-		PerPage: 50,
-	}}
+	opt, err := buildProjectJobsOptions(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectJobs", "projectId", projectId, "error", err)
+		return nil, err
+	}
 
 	for {
-		plugin.Logger(ctx).Debug("listProjectJobs", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		jobs, resp, err := conn.Jobs.ListProjectJobs(projectId, opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listProjectJobs", "projectId", projectId, "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain jobs for project_id %d\n%v", projectId, err)
+		var jobs []*api.Job
+		var resp *api.Response
+		if d.EqualsQuals["pipeline_id"] != nil {
+			pipelineId := int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+			plugin.Logger(ctx).Debug("listProjectJobs", "projectId", projectId, "pipelineId", pipelineId, "page", opt.Page, "perPage", opt.PerPage)
+			jobs, resp, err = conn.Jobs.ListPipelineJobs(projectId, pipelineId, opt)
+			if err != nil {
+				plugin.Logger(ctx).Error("listProjectJobs", "projectId", projectId, "pipelineId", pipelineId, "page", opt.Page, "error", err)
+				return nil, fmt.Errorf("unable to obtain jobs of pipeline %d for project_id %d\n%v", pipelineId, projectId, err)
+			}
+		} else {
+			plugin.Logger(ctx).Debug("listProjectJobs", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+			jobs, resp, err = conn.Jobs.ListProjectJobs(projectId, opt)
+			if err != nil {
+				plugin.Logger(ctx).Error("listProjectJobs", "projectId", projectId, "page", opt.Page, "error", err)
+				return nil, fmt.Errorf("unable to obtain jobs for project_id %d\n%v", projectId, err)
+			}
 		}
 
 		for _, job := range jobs {
@@ -67,6 +127,32 @@ func listProjectJobs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	return nil, nil
 }
 
+func getProjectJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("getProjectJob", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getProjectJob", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	jobId := int(d.EqualsQuals["id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("getProjectJob", "projectId", projectId, "jobId", jobId)
+
+	job, _, err := conn.Jobs.GetJob(projectId, jobId)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			plugin.Logger(ctx).Warn("getProjectJob", "projectId", projectId, "jobId", jobId, "no job was found, returning empty result set")
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("getProjectJob", "projectId", projectId, "jobId", jobId, "error", err)
+		return nil, fmt.Errorf("unable to obtain job %d for project_id %d\n%v", jobId, projectId, err)
+	}
+
+	plugin.Logger(ctx).Debug("getProjectJob", "completed successfully")
+	return job, nil
+}
+
 func getProjectJobTrace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Debug("getProjectJobTrace", "started")
 	conn, err := connect(ctx, d)
@@ -79,6 +165,18 @@ func getProjectJobTrace(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	jobId := h.Item.(*api.Job).ID
 	plugin.Logger(ctx).Debug("getProjectJobTrace", "projectId", projectId, "jobId", jobId)
 
+	if d.EqualsQuals["trace_tail_bytes"] != nil {
+		tailBytes := int(d.EqualsQuals["trace_tail_bytes"].GetInt64Value())
+		trace, err := getProjectJobTraceTail(conn, projectId, jobId, tailBytes)
+		if err != nil {
+			plugin.Logger(ctx).Error("getProjectJobTrace", "projectId", projectId, "jobId", jobId, "tailBytes", tailBytes, "error", err)
+			return nil, fmt.Errorf("unable to obtain trace of job %d for project_id %d\n%v", jobId, projectId, err)
+		}
+
+		plugin.Logger(ctx).Debug("getProjectJobTrace", "completed successfully", "tailBytes", tailBytes)
+		return trace, nil
+	}
+
 	traceReader, resp, err := conn.Jobs.GetTraceFile(projectId, jobId)
 	if err != nil {
 		plugin.Logger(ctx).Error("getProjectJobTrace", "projectId", projectId, "jobId", jobId, "resp", resp, "error", err)
@@ -101,6 +199,104 @@ func getProjectJobTrace(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return trace, nil
 }
 
+// Assist Functions
+func buildProjectJobsOptions(d *plugin.QueryData) (*api.ListJobsOptions, error) {
+	opt := &api.ListJobsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	// The status qualifier (including `in` lists) is pushed down as the scope of the jobs.
+	if d.Quals["status"] != nil {
+		var scope []api.BuildStateValue
+		for _, q := range d.Quals["status"].Quals {
+			for _, value := range qualStringValues(q.Value) {
+				status := strings.ToLower(value)
+				state, ok := pipelineBuildStates[status]
+				if !ok {
+					var valid []string
+					for s := range pipelineBuildStates {
+						valid = append(valid, s)
+					}
+					sort.Strings(valid)
+					return nil, fmt.Errorf("invalid status '%s' - must be one of %s", status, strings.Join(valid, ", "))
+				}
+				scope = append(scope, state)
+			}
+		}
+		opt.Scope = &scope
+	}
+
+	if d.EqualsQuals["include_retried"] != nil {
+		opt.IncludeRetried = api.Bool(d.EqualsQuals["include_retried"].GetBoolValue())
+	}
+
+	return opt, nil
+}
+
+// getProjectJobTraceTail streams the trace of a job, only keeping the last tailBytes bytes in memory.
+func getProjectJobTraceTail(conn *api.Client, projectId int, jobId int, tailBytes int) (string, error) {
+	if tailBytes <= 0 {
+		return "", fmt.Errorf("trace_tail_bytes must be greater than 0")
+	}
+
+	u := fmt.Sprintf("projects/%d/jobs/%d/trace", projectId, jobId)
+	req, err := conn.NewRequest(http.MethodGet, u, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	tail := &tailWriter{size: tailBytes}
+	if _, err = conn.Do(req, tail); err != nil {
+		return "", err
+	}
+
+	return tail.String(), nil
+}
+
+// tailWriter is an io.Writer retaining only the last size bytes written to it, in a ring buffer of at most size bytes.
+type tailWriter struct {
+	size int
+	buf  []byte
+	next int // the position in buf of the oldest byte, once buf is full
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if n > w.size {
+		p = p[n-w.size:]
+	}
+
+	// Fill the buffer up to size, then overwrite the oldest bytes.
+	if free := w.size - len(w.buf); free > 0 {
+		if free > len(p) {
+			free = len(p)
+		}
+		w.buf = append(w.buf, p[:free]...)
+		p = p[free:]
+	}
+	for len(p) > 0 {
+		copied := copy(w.buf[w.next:], p)
+		p = p[copied:]
+		w.next = (w.next + copied) % w.size
+	}
+
+	return n, nil
+}
+
+// String returns the retained bytes in the order they were written, without the remainder of a character cut by the
+// start of the tail (as invalid UTF-8 can't be returned as a column value).
+func (w *tailWriter) String() string {
+	tail := append(append(make([]byte, 0, len(w.buf)), w.buf[w.next:]...), w.buf[:w.next]...)
+	if len(w.buf) == w.size {
+		for i := 0; i < utf8.UTFMax-1 && len(tail) > 0 && !utf8.RuneStart(tail[0]); i++ {
+			tail = tail[1:]
+		}
+	}
+
+	return string(tail)
+}
+
 // Column Function
 func projectJobColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -278,9 +474,21 @@ func projectJobColumns() []*plugin.Column {
 		{
 			Name:        "trace",
 			Type:        proto.ColumnType_STRING,
-			Description: "The trace (aka log) of the job.",
+			Description: "The trace (aka log) of the job, only the last `trace_tail_bytes` bytes if specified.",
 			Hydrate:     getProjectJobTrace,
 			Transform:   transform.FromValue(),
 		},
+		{
+			Name:        "include_retried",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if retried jobs were requested, only applies when a `pipeline_id` is specified as the jobs of a project always include retried jobs.",
+			Transform:   transform.FromQual("include_retried"),
+		},
+		{
+			Name:        "trace_tail_bytes",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of bytes from the end of the trace to return in the `trace` column, the full trace is returned if not specified.",
+			Transform:   transform.FromQual("trace_tail_bytes"),
+		},
 	}
 }
@@ -0,0 +1,192 @@
+package gitlab
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/acarl005/stripansi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type JobLogLine struct {
+	LineNumber int
+	Line       string
+	Section    string
+}
+
+// sectionMarkerPattern matches the markers of collapsible sections, e.g. `section_start:1560896352:my_section[collapsed=true]`.
+var sectionMarkerPattern = regexp.MustCompile(`section_(start|end):\d+:([^\s\[\r]+)(?:\[[^\]]*\])?\r?`)
+
+func tableProjectJobLogLine() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_job_log_line",
+		Description: "Obtain the lines of the trace (aka log) of a specific job from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "job_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "pattern",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listProjectJobLogLines,
+		},
+		Columns: projectJobLogLineColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectJobLogLines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectJobLogLines", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectJobLogLines", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	projectId := int(q["project_id"].GetInt64Value())
+	jobId := int(q["job_id"].GetInt64Value())
+
+	var pattern *regexp.Regexp
+	if q["pattern"] != nil {
+		pattern, err = regexp.Compile(q["pattern"].GetStringValue())
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectJobLogLines", "pattern", q["pattern"].GetStringValue(), "error", err)
+			return nil, fmt.Errorf("invalid pattern '%s'\n%v", q["pattern"].GetStringValue(), err)
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listProjectJobLogLines", "projectId", projectId, "jobId", jobId)
+	traceReader, _, err := conn.Jobs.GetTraceFile(projectId, jobId)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectJobLogLines", "projectId", projectId, "jobId", jobId, "error", err)
+		return nil, fmt.Errorf("unable to obtain trace of job %d for project_id %d\n%v", jobId, projectId, err)
+	}
+
+	reader := bufio.NewReader(traceReader)
+	var sections []string
+	lineNumber := 0
+	for {
+		raw, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			plugin.Logger(ctx).Error("listProjectJobLogLines", "projectId", projectId, "jobId", jobId, "lineNumber", lineNumber, "error", err)
+			return nil, fmt.Errorf("failed to read trace of job %d for project_id %d\n%v", jobId, projectId, err)
+		}
+		if raw == "" && err == io.EOF {
+			break
+		}
+
+		lineNumber++
+		line := parseJobLogLine(raw, &sections)
+		line.LineNumber = lineNumber
+
+		if pattern == nil || pattern.MatchString(line.Line) {
+			d.StreamListItem(ctx, line)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectJobLogLines", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listProjectJobLogLines", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// parseJobLogLine removes the section markers and ANSI codes from a raw line of a trace, updating the stack of open sections.
+func parseJobLogLine(raw string, sections *[]string) *JobLogLine {
+	raw = strings.TrimRight(raw, "\r\n")
+
+	for _, marker := range sectionMarkerPattern.FindAllStringSubmatch(raw, -1) {
+		name := marker[2]
+		if marker[1] == "start" {
+			*sections = append(*sections, name)
+			continue
+		}
+
+		// Close the section along with any nested sections which weren't closed.
+		for i := len(*sections) - 1; i >= 0; i-- {
+			if (*sections)[i] == name {
+				*sections = (*sections)[:i]
+				break
+			}
+		}
+	}
+
+	text := stripansi.Strip(sectionMarkerPattern.ReplaceAllString(raw, ""))
+
+	// A carriage return overwrites the line in a terminal (e.g. progress output), so only the final text is kept.
+	if i := strings.LastIndex(strings.TrimRight(text, "\r"), "\r"); i >= 0 {
+		text = text[i+1:]
+	}
+	text = strings.TrimRight(text, "\r")
+
+	line := &JobLogLine{Line: text}
+	if len(*sections) > 0 {
+		line.Section = (*sections)[len(*sections)-1]
+	}
+
+	return line
+}
+
+// Column Function
+func projectJobLogLineColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the job was run against - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "job_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the job - link to `gitlab_project_job.id`.",
+			Transform:   transform.FromQual("job_id"),
+		},
+		{
+			Name:        "line_number",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of the line within the trace, starting at 1.",
+		},
+		{
+			Name:        "line",
+			Type:        proto.ColumnType_STRING,
+			Description: "The text of the line, with ANSI codes and section markers removed.",
+			Transform:   transform.FromField("Line"),
+		},
+		{
+			Name:        "section",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the (innermost) collapsible section the line belongs to, e.g. `step_script`.",
+		},
+		{
+			Name:        "pattern",
+			Type:        proto.ColumnType_STRING,
+			Description: "A regular expression (RE2 syntax) the lines were matched against, only matching lines are returned.",
+			Transform:   transform.FromQual("pattern"),
+		},
+	}
+}
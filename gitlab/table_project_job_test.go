@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTailWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		writes []string
+		want   string
+	}{
+		{
+			name:   "shorter than size",
+			size:   10,
+			writes: []string{"abc", "def"},
+			want:   "abcdef",
+		},
+		{
+			name:   "exactly size",
+			size:   6,
+			writes: []string{"abc", "def"},
+			want:   "abcdef",
+		},
+		{
+			name:   "wraps around",
+			size:   4,
+			writes: []string{"abc", "def", "g"},
+			want:   "defg",
+		},
+		{
+			name:   "single write larger than size",
+			size:   3,
+			writes: []string{"ab", "cdefgh"},
+			want:   "fgh",
+		},
+		{
+			name:   "many small writes",
+			size:   5,
+			writes: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"},
+			want:   "hijkl",
+		},
+		{
+			// "é" is encoded as 0xc3 0xa9, so a tail of 3 bytes starts within it.
+			name:   "cut within a character",
+			size:   3,
+			writes: []string{"café", "ok"},
+			want:   "ok",
+		},
+		{
+			name:   "cut within a 4 byte character",
+			size:   6,
+			writes: []string{"🚀", "\n", "done"},
+			want:   "\ndone",
+		},
+		{
+			name: "nothing written",
+			size: 5,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &tailWriter{size: tt.size}
+			for _, s := range tt.writes {
+				n, err := w.Write([]byte(s))
+				if err != nil || n != len(s) {
+					t.Fatalf("Write(%q) = %d, %v", s, n, err)
+				}
+			}
+			got := w.String()
+			if got != tt.want {
+				t.Errorf("tail = %q, want %q", got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("tail %q isn't valid UTF-8", got)
+			}
+		})
+	}
+}
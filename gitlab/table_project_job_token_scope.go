@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+type ProjectJobTokenScope struct {
+	InboundEnabled  bool
+	OutboundEnabled bool
+	TargetProject   *api.Project
+}
+
+func tableProjectJobTokenScope() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_job_token_scope",
+		Description: "Obtain the projects allowed to access a specific project with a CI/CD job token from within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectJobTokenScopes,
+		},
+		Columns: projectJobTokenScopeColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectJobTokenScopes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectJobTokenScopes", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectJobTokenScopes", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("listProjectJobTokenScopes", "projectId", projectId)
+
+	settings, _, err := conn.JobTokenScope.GetProjectJobTokenAccessSettings(projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectJobTokenScopes", "projectId", projectId, "error", err)
+		return nil, fmt.Errorf("unable to obtain job token access settings for project_id %d\n%v", projectId, err)
+	}
+
+	opt := &api.GetJobTokenInboundAllowListOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	streamed := 0
+	for {
+		plugin.Logger(ctx).Debug("listProjectJobTokenScopes", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		projects, resp, err := conn.JobTokenScope.GetProjectJobTokenInboundAllowList(projectId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectJobTokenScopes", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain job token inbound allowlist for project_id %d\n%v", projectId, err)
+		}
+
+		for _, project := range projects {
+			d.StreamListItem(ctx, &ProjectJobTokenScope{
+				InboundEnabled:  settings.InboundEnabled,
+				OutboundEnabled: settings.OutboundEnabled,
+				TargetProject:   project,
+			})
+			streamed++
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectJobTokenScopes", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	// A single row without a target project is returned for an empty allowlist, so the settings are still obtainable.
+	if streamed == 0 {
+		d.StreamListItem(ctx, &ProjectJobTokenScope{
+			InboundEnabled:  settings.InboundEnabled,
+			OutboundEnabled: settings.OutboundEnabled,
+		})
+	}
+
+	plugin.Logger(ctx).Debug("listProjectJobTokenScopes", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectJobTokenScopeColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the job token scope belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "inbound_enabled",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if only the projects in the allowlist can access the project with a job token.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "outbound_enabled",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the job tokens of the project can only access projects in its (deprecated) outbound scope.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "target_project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project in the allowlist - link to `gitlab_project.id`, null if the allowlist is empty.",
+			Transform:   transform.FromField("TargetProject.ID"),
+		},
+		{
+			Name:        "target_project_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the project in the allowlist.",
+			Transform:   transform.FromField("TargetProject.Name"),
+		},
+		{
+			Name:        "target_project_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full path of the project in the allowlist.",
+			Transform:   transform.FromField("TargetProject.PathWithNamespace"),
+		},
+		{
+			Name:        "target_project_web_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url of the project in the allowlist.",
+			Transform:   transform.FromField("TargetProject.WebURL"),
+		},
+	}
+}
@@ -11,17 +11,19 @@ import (
 )
 
 type ProjectMember struct {
-	ID          int
-	Username    string
-	Name        string
-	State       string
-	CreatedAt   *time.Time
-	ExpiresAt   *api.ISOTime
-	AccessLevel int
-	AccessDesc  string
-	WebUrl      string
-	AvatarUrl   string
-	ProjectID   int
+	ID               int
+	Username         string
+	Name             string
+	State            string
+	CreatedAt        *time.Time
+	ExpiresAt        *api.ISOTime
+	AccessLevel      int
+	AccessDesc       string
+	WebUrl           string
+	AvatarUrl        string
+	ProjectID        int
+	MembershipSource string
+	Inherited        *bool
 }
 
 func tableProjectMember() *plugin.Table {
@@ -29,8 +31,18 @@ func tableProjectMember() *plugin.Table {
 		Name:        "gitlab_project_member",
 		Description: "Obtain information about members of a specific project within the GitLab instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
-			Hydrate:    listProjectMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "inherited",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listProjectMembers,
 		},
 		Columns: projectMemberColumns(),
 	}
@@ -45,22 +57,41 @@ func listProjectMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
-	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	q := d.EqualsQuals
+	projectId := int(q["project_id"].GetInt64Value())
 	opt := &api.ListProjectMembersOptions{ListOptions: api.ListOptions{
 		Page:    1,
 		PerPage: 50,
 	}}
 
+	// Direct members only can be obtained without the members/all endpoint or resolving the source of the membership.
+	directOnly := q["inherited"] != nil && !q["inherited"].GetBoolValue()
+	listMembers := conn.ProjectMembers.ListAllProjectMembers
+	if directOnly {
+		listMembers = conn.ProjectMembers.ListProjectMembers
+	}
+
+	var sourceOf func(int) string
+	if directOnly {
+		sourceOf = func(int) string { return membershipSourceDirect }
+	} else if q["inherited"] != nil || isColumnRequested(d, "membership_source", "inherited") {
+		sourceOf, err = projectMembershipSources(ctx, conn, projectId)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectMembers", "projectId", projectId, "error", err)
+			return nil, fmt.Errorf("unable to obtain membership sources for project_id %d\n%v", projectId, err)
+		}
+	}
+
 	for {
 		plugin.Logger(ctx).Debug("listProjectMembers", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		members, resp, err := conn.ProjectMembers.ListAllProjectMembers(projectId, opt)
+		members, resp, err := listMembers(projectId, opt)
 		if err != nil {
 			plugin.Logger(ctx).Error("listProjectMembers", "projectId", projectId, "page", opt.Page, "error", err)
 			return nil, fmt.Errorf("unable to obtain members for project_id %d\n%v", projectId, err)
 		}
 
 		for _, member := range members {
-			d.StreamListItem(ctx, &ProjectMember{
+			output := &ProjectMember{
 				ID:          member.ID,
 				Username:    member.Username,
 				Name:        member.Name,
@@ -72,7 +103,13 @@ func listProjectMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 				AccessDesc:  parseAccessLevel(int(member.AccessLevel)),
 				ProjectID:   projectId,
 				CreatedAt:   member.CreatedAt,
-			})
+			}
+			if sourceOf != nil {
+				output.MembershipSource = sourceOf(member.ID)
+				output.Inherited = api.Bool(output.MembershipSource != membershipSourceDirect)
+			}
+
+			d.StreamListItem(ctx, output)
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				plugin.Logger(ctx).Debug("listProjectMembers", "completed successfully")
@@ -91,6 +128,41 @@ func listProjectMembers(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return nil, nil
 }
 
+// Assist Functions
+
+// projectMembershipSources returns a func resolving how a user obtained their membership of a project - direct members
+// are returned by the members endpoint of the project, inherited members by the members/all endpoint of the parent group
+// and any remaining members obtain access through a group the project has been shared with.
+func projectMembershipSources(ctx context.Context, conn *api.Client, projectId int) (func(int) string, error) {
+	direct, err := listMemberIds(func(opt *api.ListOptions) ([]int, *api.Response, error) {
+		members, resp, err := conn.ProjectMembers.ListProjectMembers(projectId, &api.ListProjectMembersOptions{ListOptions: *opt})
+		ids := make([]int, 0, len(members))
+		for _, member := range members {
+			ids = append(ids, member.ID)
+		}
+		return ids, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	project, _, err := conn.Projects.GetProject(projectId, &api.GetProjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	inherited := map[int]bool{}
+	if project.Namespace != nil && project.Namespace.Kind == "group" {
+		plugin.Logger(ctx).Debug("projectMembershipSources", "projectId", projectId, "namespaceId", project.Namespace.ID)
+		inherited, err = listGroupMemberIds(conn, project.Namespace.ID, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return membershipSourceResolver(direct, inherited), nil
+}
+
 // Column Function
 func projectMemberColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -153,5 +225,16 @@ func projectMemberColumns() []*plugin.Column {
 			Type:        proto.ColumnType_TIMESTAMP,
 			Description: "Timestamp at which the user was created.",
 		},
+		{
+			Name:        "membership_source",
+			Type:        proto.ColumnType_STRING,
+			Description: "How the member obtained access to the project (direct, inherited from a parent group or shared_group).",
+		},
+		{
+			Name:        "inherited",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the membership is not a direct membership of the project, set to false to only return direct members.",
+			Transform:   transform.FromField("Inherited"),
+		},
 	}
 }
@@ -3,14 +3,31 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
-	"strings"
-	"time"
 )
 
+// pipelineBuildStates maps the supported values of the status qualifier to the API build states.
+var pipelineBuildStates = map[string]api.BuildStateValue{
+	string(api.Created):            api.Created,
+	string(api.WaitingForResource): api.WaitingForResource,
+	string(api.Preparing):          api.Preparing,
+	string(api.Pending):            api.Pending,
+	string(api.Running):            api.Running,
+	string(api.Success):            api.Success,
+	string(api.Failed):             api.Failed,
+	string(api.Canceled):           api.Canceled,
+	string(api.Skipped):            api.Skipped,
+	string(api.Manual):             api.Manual,
+	string(api.Scheduled):          api.Scheduled,
+}
+
 func tableProjectPipeline() *plugin.Table {
 	return &plugin.Table{
 		Name:        "gitlab_project_pipeline",
@@ -26,18 +43,54 @@ func tableProjectPipeline() *plugin.Table {
 					Require:   plugin.Optional,
 					Operators: []string{">", ">=", "=", "<", "<="},
 				},
+				{
+					Name:      "created_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
 				{
 					Name:      "status",
 					Require:   plugin.Optional,
 					Operators: []string{"="},
 				},
+				{
+					Name:      "ref",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "sha",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "source",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "username",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "yaml_errors",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
 			},
 			Hydrate: listProjectPipelines,
 		},
-		Columns: projectPipelineColumns(),
+		Columns: append(projectPipelineColumns(), projectPipelineQualifierColumns()...),
 	}
 }
 
+// Hydrate Functions
 func listProjectPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Debug("listProjectPipelines", "started")
 	conn, err := connect(ctx, d)
@@ -47,61 +100,16 @@ func listProjectPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	}
 
 	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
-	opt := &api.ListProjectPipelinesOptions{ListOptions: api.ListOptions{
-		Page:    1,
-		PerPage: 50,
-	}}
-
-	if d.Quals["updated_at"] != nil {
-		for _, q := range d.Quals["updated_at"].Quals {
-			givenTime := q.Value.GetTimestampValue().AsTime()
-			beforeTime := givenTime.Add(time.Duration(-1) * time.Second)
-			afterTime := givenTime.Add(time.Second * 1)
-
-			switch q.Operator {
-			case ">":
-				opt.UpdatedAfter = &afterTime
-			case ">=":
-				opt.UpdatedAfter = &givenTime
-			case "=":
-				opt.UpdatedAfter = &beforeTime
-				opt.UpdatedBefore = &afterTime
-			case "<=":
-				opt.UpdatedBefore = &givenTime
-			case "<":
-				opt.UpdatedBefore = &beforeTime
-			}
-		}
-	}
-
-	if d.EqualsQuals["status"] != nil {
-		s := d.EqualsQuals["status"].GetStringValue()
-
-		switch strings.ToLower(s) {
-		case "pending":
-			opt.Status = api.BuildState(api.Pending)
-		case "created":
-			opt.Status = api.BuildState(api.Created)
-		case "running":
-			opt.Status = api.BuildState(api.Canceled)
-		case "success":
-			opt.Status = api.BuildState(api.Success)
-		case "failed":
-			opt.Status = api.BuildState(api.Failed)
-		case "canceled":
-			opt.Status = api.BuildState(api.Canceled)
-		case "skipped":
-			opt.Status = api.BuildState(api.Skipped)
-		case "manual":
-			opt.Status = api.BuildState(api.Manual)
-		default:
-			return nil, nil
-		}
+	opt, err := buildProjectPipelinesOptions(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectPipelines", "projectId", projectId, "error", err)
+		return nil, err
 	}
+	options := projectPipelinesRequestOptions(d)
 
 	for {
 		plugin.Logger(ctx).Debug("listProjectPipelines", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
-		pipelines, resp, err := conn.Pipelines.ListProjectPipelines(projectId, opt)
+		pipelines, resp, err := conn.Pipelines.ListProjectPipelines(projectId, opt, options...)
 		if err != nil {
 			plugin.Logger(ctx).Error("listProjectPipelines", "projectId", projectId, "page", opt.Page, "error", err)
 			return nil, fmt.Errorf("unable to obtain pipelines for project_id %d\n%v", projectId, err)
@@ -127,6 +135,73 @@ func listProjectPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	return nil, nil
 }
 
+// Assist Functions
+
+// buildProjectPipelinesOptions translates the qualifiers of the gitlab_project_pipeline table into the API options.
+func buildProjectPipelinesOptions(d *plugin.QueryData) (*api.ListProjectPipelinesOptions, error) {
+	q := d.EqualsQuals
+	opt := &api.ListProjectPipelinesOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	opt.UpdatedAfter, opt.UpdatedBefore = timeRangeQualifiers(d, "updated_at")
+
+	if q["status"] != nil {
+		status := strings.ToLower(q["status"].GetStringValue())
+		state, ok := pipelineBuildStates[status]
+		if !ok {
+			var valid []string
+			for s := range pipelineBuildStates {
+				valid = append(valid, s)
+			}
+			sort.Strings(valid)
+			return nil, fmt.Errorf("invalid status '%s' - must be one of %s", status, strings.Join(valid, ", "))
+		}
+		opt.Status = api.BuildState(state)
+	}
+
+	if q["ref"] != nil {
+		opt.Ref = api.String(q["ref"].GetStringValue())
+	}
+
+	if q["sha"] != nil {
+		opt.SHA = api.String(q["sha"].GetStringValue())
+	}
+
+	if q["source"] != nil {
+		opt.Source = api.String(q["source"].GetStringValue())
+	}
+
+	if q["username"] != nil {
+		opt.Username = api.String(q["username"].GetStringValue())
+	}
+
+	if q["yaml_errors"] != nil {
+		opt.YamlErrors = api.Bool(q["yaml_errors"].GetBoolValue())
+	}
+
+	if q["name"] != nil {
+		opt.Name = api.String(q["name"].GetStringValue())
+	}
+
+	return opt, nil
+}
+
+// projectPipelinesRequestOptions returns the request options for the created_at range, which the SDK options don't support.
+func projectPipelinesRequestOptions(d *plugin.QueryData) []api.RequestOptionFunc {
+	var options []api.RequestOptionFunc
+	createdAfter, createdBefore := timeRangeQualifiers(d, "created_at")
+	if createdAfter != nil {
+		options = append(options, withQueryParam("created_after", createdAfter.Format(time.RFC3339)))
+	}
+	if createdBefore != nil {
+		options = append(options, withQueryParam("created_before", createdBefore.Format(time.RFC3339)))
+	}
+
+	return options
+}
+
 // Column Function
 func projectPipelineColumns() []*plugin.Column {
 	return []*plugin.Column{
@@ -138,7 +213,7 @@ func projectPipelineColumns() []*plugin.Column {
 		{
 			Name:        "status",
 			Type:        proto.ColumnType_STRING,
-			Description: "The status of the pipeline (success/failed/canceled).",
+			Description: "The status of the pipeline (created, waiting_for_resource, preparing, pending, running, success, failed, canceled, skipped, manual or scheduled).",
 		},
 		{
 			Name:        "ref",
@@ -180,3 +255,26 @@ func projectPipelineColumns() []*plugin.Column {
 		},
 	}
 }
+
+func projectPipelineQualifierColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "username",
+			Type:        proto.ColumnType_STRING,
+			Description: "The username of the user who triggered the pipelines, used to filter the pipelines.",
+			Transform:   transform.FromQual("username"),
+		},
+		{
+			Name:        "yaml_errors",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if only pipelines with (true) or without (false) invalid configurations were requested.",
+			Transform:   transform.FromQual("yaml_errors"),
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the pipelines, used to filter the pipelines.",
+			Transform:   transform.FromQual("name"),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func TestBuildProjectPipelinesOptions(t *testing.T) {
+	given := time.Unix(1700000000, 0).UTC()
+	tests := []struct {
+		name    string
+		quals   []*quals.Qual
+		want    *api.ListProjectPipelinesOptions
+		wantErr bool
+		query   url.Values
+	}{
+		{
+			name:  "no qualifiers",
+			want:  &api.ListProjectPipelinesOptions{},
+			query: url.Values{},
+		},
+		{
+			name:  "status",
+			quals: []*quals.Qual{testQual("status", "=", "Success")},
+			want:  &api.ListProjectPipelinesOptions{Status: api.BuildState(api.Success)},
+			query: url.Values{},
+		},
+		{
+			name:    "invalid status",
+			quals:   []*quals.Qual{testQual("status", "=", "done")},
+			wantErr: true,
+		},
+		{
+			name: "string & bool qualifiers",
+			quals: []*quals.Qual{
+				testQual("ref", "=", "main"),
+				testQual("sha", "=", "e83c5163316f89bfbde7d9ab23ca2e25604af290"),
+				testQual("source", "=", "schedule"),
+				testQual("username", "=", "jdoe"),
+				testQual("yaml_errors", "=", true),
+				testQual("name", "=", "nightly"),
+			},
+			want: &api.ListProjectPipelinesOptions{
+				Ref:        api.String("main"),
+				SHA:        api.String("e83c5163316f89bfbde7d9ab23ca2e25604af290"),
+				Source:     api.String("schedule"),
+				Username:   api.String("jdoe"),
+				YamlErrors: api.Bool(true),
+				Name:       api.String("nightly"),
+			},
+			query: url.Values{},
+		},
+		{
+			name: "updated_at range",
+			quals: []*quals.Qual{
+				testQual("updated_at", ">", given),
+				testQual("updated_at", "<=", given.Add(time.Hour)),
+			},
+			want: &api.ListProjectPipelinesOptions{
+				UpdatedAfter:  api.Time(given),
+				UpdatedBefore: api.Time(given.Add(time.Hour)),
+			},
+			query: url.Values{},
+		},
+		{
+			name:  "updated_at equals",
+			quals: []*quals.Qual{testQual("updated_at", "=", given)},
+			want: &api.ListProjectPipelinesOptions{
+				UpdatedAfter:  api.Time(given.Add(-time.Second)),
+				UpdatedBefore: api.Time(given.Add(time.Second)),
+			},
+			query: url.Values{},
+		},
+		{
+			name: "created_at range",
+			quals: []*quals.Qual{
+				testQual("created_at", ">=", given),
+				testQual("created_at", "<", given.Add(time.Hour)),
+			},
+			want: &api.ListProjectPipelinesOptions{},
+			query: url.Values{
+				"created_after":  {"2023-11-14T22:13:20Z"},
+				"created_before": {"2023-11-14T23:13:20Z"},
+			},
+		},
+		{
+			name:  "created_at equals",
+			quals: []*quals.Qual{testQual("created_at", "=", given)},
+			want:  &api.ListProjectPipelinesOptions{},
+			query: url.Values{
+				"created_after":  {"2023-11-14T22:13:19Z"},
+				"created_before": {"2023-11-14T22:13:21Z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testQueryData(tt.quals...)
+			got, err := buildProjectPipelinesOptions(d)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tt.want.ListOptions = api.ListOptions{Page: 1, PerPage: 50}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("options = %+v, want %+v", got, tt.want)
+			}
+
+			req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1/pipelines", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, fn := range projectPipelinesRequestOptions(d) {
+				if err := fn(req); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if query := req.URL.Query(); !reflect.DeepEqual(query, tt.query) {
+				t.Errorf("query = %v, want %v", query, tt.query)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableProjectPushRule() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_push_rule",
+		Description: "Obtain information on push rules for a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectPushRules,
+		},
+		Columns: projectPushRuleColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectPushRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectPushRules", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectPushRules", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("listProjectPushRules", "projectId", projectId)
+
+	pushRules, _, err := conn.Projects.GetProjectPushRules(projectId)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectPushRules", "projectId", projectId, "error", err)
+		return nil, fmt.Errorf("unable to obtain push rules for project_id %d\n%v", projectId, err)
+	}
+
+	d.StreamListItem(ctx, pushRules)
+
+	plugin.Logger(ctx).Debug("listProjectPushRules", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectPushRuleColumns() []*plugin.Column {
+	return append(pushRuleColumns(), &plugin.Column{
+		Name:        "project_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the project the push rules belong to - link to `gitlab_project.id`.",
+		Transform:   transform.FromQual("project_id"),
+	})
+}
@@ -0,0 +1,159 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableProjectRepositoryCompare() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_repository_compare",
+		Description: "Obtain the commits and diffs between two refs (branches, tags or commits) of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "project_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "from",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "to",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "straight",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listRepoCompare,
+		},
+		Columns: repoCompareColumns(),
+	}
+}
+
+// Hydrate Functions
+func listRepoCompare(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listRepoCompare", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRepoCompare", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	projectId := int(q["project_id"].GetInt64Value())
+	from := q["from"].GetStringValue()
+	to := q["to"].GetStringValue()
+	opt := &api.CompareOptions{
+		From: &from,
+		To:   &to,
+	}
+
+	if q["straight"] != nil {
+		straight := q["straight"].GetBoolValue()
+		opt.Straight = &straight
+		plugin.Logger(ctx).Debug("listRepoCompare", "filter[straight]", straight)
+	}
+
+	plugin.Logger(ctx).Debug("listRepoCompare", "projectId", projectId, "from", from, "to", to)
+	compare, _, err := conn.Repositories.Compare(projectId, opt)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRepoCompare", "projectId", projectId, "from", from, "to", to, "error", err)
+		return nil, fmt.Errorf("unable to compare %s to %s for project_id %d\n%v", from, to, projectId, err)
+	}
+
+	d.StreamListItem(ctx, compare)
+
+	plugin.Logger(ctx).Debug("listRepoCompare", "completed successfully")
+	return nil, nil
+}
+
+// Transform Functions
+func compareCommitCountTransform(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	compare := input.HydrateItem.(*api.Compare)
+	return len(compare.Commits), nil
+}
+
+func compareDiffCountTransform(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	compare := input.HydrateItem.(*api.Compare)
+	return len(compare.Diffs), nil
+}
+
+// Column Function
+func repoCompareColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "from",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ref (branch, tag or commit) to compare from.",
+			Transform:   transform.FromQual("from"),
+		},
+		{
+			Name:        "to",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ref (branch, tag or commit) to compare to.",
+			Transform:   transform.FromQual("to"),
+		},
+		{
+			Name:        "straight",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the comparison was made directly between from and to (true) or using the merge base (false, default).",
+			Transform:   transform.FromQual("straight"),
+		},
+		{
+			Name:        "commit_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The ID of the head commit of the comparison.",
+			Transform:   transform.FromField("Commit.ID"),
+		},
+		{
+			Name:        "commit_count",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of commits between the refs.",
+			Transform:   transform.From(compareCommitCountTransform),
+		},
+		{
+			Name:        "commits",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of the commits between the refs.",
+		},
+		{
+			Name:        "diff_count",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of files changed between the refs.",
+			Transform:   transform.From(compareDiffCountTransform),
+		},
+		{
+			Name:        "diffs",
+			Type:        proto.ColumnType_JSON,
+			Description: "An array of the file diffs between the refs.",
+		},
+		{
+			Name:        "compare_timeout",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the comparison timed out on the server, in which case the results may be incomplete.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "compare_same_ref",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the from and to refs resolve to the same commit.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project this repository belongs to - link `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+	}
+}
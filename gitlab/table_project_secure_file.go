@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+// SecureFile is a CI/CD secure file of a project, these aren't supported by the SDK.
+type SecureFile struct {
+	ID                int                    `json:"id"`
+	Name              string                 `json:"name"`
+	Checksum          string                 `json:"checksum"`
+	ChecksumAlgorithm string                 `json:"checksum_algorithm"`
+	CreatedAt         *time.Time             `json:"created_at"`
+	ExpiresAt         *time.Time             `json:"expires_at"`
+	FileExtension     string                 `json:"file_extension"`
+	Metadata          map[string]interface{} `json:"metadata"`
+}
+
+func tableProjectSecureFile() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_secure_file",
+		Description: "Obtain information about the CI/CD secure files of a specific project within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectSecureFiles,
+		},
+		Columns: projectSecureFileColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectSecureFiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectSecureFiles", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectSecureFiles", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	opt := &api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listProjectSecureFiles", "projectId", projectId, "page", opt.Page, "perPage", opt.PerPage)
+		req, err := conn.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/secure_files", projectId), opt, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectSecureFiles", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain secure files for project_id %d\n%v", projectId, err)
+		}
+
+		var files []*SecureFile
+		resp, err := conn.Do(req, &files)
+		if err != nil {
+			plugin.Logger(ctx).Error("listProjectSecureFiles", "projectId", projectId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain secure files for project_id %d\n%v", projectId, err)
+		}
+
+		for _, file := range files {
+			d.StreamListItem(ctx, file)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listProjectSecureFiles", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listProjectSecureFiles", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func projectSecureFileColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the secure file.",
+		},
+		{
+			Name:        "project_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the project the secure file belongs to - link to `gitlab_project.id`.",
+			Transform:   transform.FromQual("project_id"),
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the secure file.",
+		},
+		{
+			Name:        "file_extension",
+			Type:        proto.ColumnType_STRING,
+			Description: "The extension of the secure file.",
+		},
+		{
+			Name:        "checksum",
+			Type:        proto.ColumnType_STRING,
+			Description: "The checksum of the secure file.",
+		},
+		{
+			Name:        "checksum_algorithm",
+			Type:        proto.ColumnType_STRING,
+			Description: "The algorithm used for the checksum of the secure file.",
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the secure file was uploaded.",
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the certificate or profile contained in the secure file expires, if parsed by GitLab.",
+		},
+		{
+			Name:        "metadata",
+			Type:        proto.ColumnType_JSON,
+			Description: "The metadata parsed by GitLab from certificates and provisioning profiles, such as the issuer and subject.",
+		},
+	}
+}
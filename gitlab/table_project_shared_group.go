@@ -0,0 +1,118 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+type SharedGroup struct {
+	GroupID     int
+	Name        string
+	FullPath    string
+	AccessLevel int
+	AccessDesc  string
+	ExpiresAt   *api.ISOTime
+}
+
+func tableProjectSharedGroup() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_project_shared_group",
+		Description: "Obtain information about the groups a specific project has been shared with within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("project_id"),
+			Hydrate:    listProjectSharedGroups,
+		},
+		Columns: projectSharedGroupColumns(),
+	}
+}
+
+// Hydrate Functions
+func listProjectSharedGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listProjectSharedGroups", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectSharedGroups", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("listProjectSharedGroups", "projectId", projectId)
+
+	project, _, err := conn.Projects.GetProject(projectId, &api.GetProjectOptions{})
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectSharedGroups", "projectId", projectId, "error", err)
+		return nil, fmt.Errorf("unable to obtain shared groups for project_id %d\n%v", projectId, err)
+	}
+
+	for _, group := range project.SharedWithGroups {
+		d.StreamListItem(ctx, &SharedGroup{
+			GroupID:     group.GroupID,
+			Name:        group.GroupName,
+			FullPath:    group.GroupFullPath,
+			AccessLevel: group.GroupAccessLevel,
+			AccessDesc:  parseAccessLevel(group.GroupAccessLevel),
+		})
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listProjectSharedGroups", "completed successfully")
+	return nil, nil
+}
+
+// Column Functions
+func projectSharedGroupColumns() []*plugin.Column {
+	return append(sharedGroupColumns(), &plugin.Column{
+		Name:        "project_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the project which has been shared - link to `gitlab_project.id`.",
+		Transform:   transform.FromQual("project_id"),
+	})
+}
+
+func sharedGroupColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "shared_with_group_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the group shared with - link to `gitlab_group.id`.",
+			Transform:   transform.FromField("GroupID"),
+		},
+		{
+			Name:        "shared_with_group_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the group shared with.",
+			Transform:   transform.FromField("Name"),
+		},
+		{
+			Name:        "shared_with_group_full_path",
+			Type:        proto.ColumnType_STRING,
+			Description: "The full path of the group shared with.",
+			Transform:   transform.FromField("FullPath"),
+		},
+		{
+			Name:        "access_level",
+			Type:        proto.ColumnType_INT,
+			Description: "The maximum access level granted to members of the group shared with.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "access_desc",
+			Type:        proto.ColumnType_STRING,
+			Description: "The descriptive of the maximum access level granted to members of the group shared with.",
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "The date on which the share expires.",
+			Transform:   transform.FromField("ExpiresAt").NullIfZero().Transform(isoTimeTransform),
+		},
+	}
+}
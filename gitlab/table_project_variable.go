@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -30,6 +31,7 @@ func tableProjectVariable() *plugin.Table {
 	}
 }
 
+// Hydrate Functions
 func listProjectVars(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Debug("listProjectVars", "started")
 	conn, err := connect(ctx, d)
@@ -38,6 +40,12 @@ func listProjectVars(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
+	mode, err := variableValueMode(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listProjectVars", "error", err)
+		return nil, err
+	}
+
 	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
 	opt := &api.ListProjectVariablesOptions{
 		Page:    1,
@@ -53,7 +61,7 @@ func listProjectVars(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		}
 
 		for _, v := range vars {
-			d.StreamListItem(ctx, v)
+			d.StreamListItem(ctx, parseCIVariable(mode, v))
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
 				plugin.Logger(ctx).Debug("listProjectVars", "completed successfully")
@@ -80,6 +88,12 @@ func getProjectVar(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, fmt.Errorf("unable to establish a connection: %v", err)
 	}
 
+	mode, err := variableValueMode(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getProjectVar", "error", err)
+		return nil, err
+	}
+
 	projectId := int(d.EqualsQuals["project_id"].GetInt64Value())
 	key := d.EqualsQuals["key"].GetStringValue()
 	opt := &api.GetProjectVariableOptions{}
@@ -92,7 +106,7 @@ func getProjectVar(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	}
 
 	plugin.Logger(ctx).Debug("getProjectVar", "completed successfully")
-	return v, nil
+	return parseCIVariable(mode, v), nil
 }
 
 // Column Function
@@ -106,7 +120,19 @@ func projectVarColumns() []*plugin.Column {
 		{
 			Name:        "value",
 			Type:        proto.ColumnType_STRING,
-			Description: "The value of the variable.",
+			Description: "The value of the variable (plaintext, redacted, sha256 hashed or omitted as per the variable_value_mode of the connection).",
+		},
+		{
+			Name:        "value_length",
+			Type:        proto.ColumnType_INT,
+			Description: "The length in characters of the value of the variable.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "looks_like_secret",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Indicates if the variable appears to hold a secret (based upon its key, a well known token format or the randomness of its value).",
+			Transform:   transform.FromGo(),
 		},
 		{
 			Name:        "variable_type",
@@ -0,0 +1,151 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableTopic() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_topic",
+		Description: "Obtain information about the project topics within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "search",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listTopics,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getTopic,
+		},
+		Columns: topicColumns(),
+	}
+}
+
+// Hydrate Functions
+func listTopics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listTopics", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listTopics", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	opt := &api.ListTopicsOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	if d.EqualsQuals["search"] != nil {
+		search := d.EqualsQuals["search"].GetStringValue()
+		opt.Search = &search
+		plugin.Logger(ctx).Debug("listTopics", "filter[search]", search)
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listTopics", "page", opt.Page, "perPage", opt.PerPage)
+		topics, resp, err := conn.Topics.ListTopics(opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listTopics", "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain topics\n%v", err)
+		}
+
+		for _, topic := range topics {
+			d.StreamListItem(ctx, topic)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listTopics", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listTopics", "completed successfully")
+	return nil, nil
+}
+
+func getTopic(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("getTopic", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getTopic", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	topicId := int(d.EqualsQuals["id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("getTopic", "topicId", topicId)
+
+	topic, _, err := conn.Topics.GetTopic(topicId)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			plugin.Logger(ctx).Warn("getTopic", "topicId", topicId, "no topic was found, returning empty result set")
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("getTopic", "topicId", topicId, "error", err)
+		return nil, fmt.Errorf("unable to obtain topic with id %d\n%v", topicId, err)
+	}
+
+	plugin.Logger(ctx).Debug("getTopic", "completed successfully")
+	return topic, nil
+}
+
+// Column Function
+func topicColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the topic.",
+		},
+		{
+			Name:        "name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the topic.",
+		},
+		{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the topic.",
+		},
+		{
+			Name:        "description",
+			Type:        proto.ColumnType_STRING,
+			Description: "The description of the topic.",
+		},
+		{
+			Name:        "total_projects_count",
+			Type:        proto.ColumnType_INT,
+			Description: "The number of projects tagged with the topic.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "avatar_url",
+			Type:        proto.ColumnType_STRING,
+			Description: "The url of the topic avatar.",
+			Transform:   transform.FromField("AvatarURL"),
+		},
+		{
+			Name:        "search",
+			Type:        proto.ColumnType_STRING,
+			Description: "The search term used to filter the topics by name.",
+			Transform:   transform.FromQual("search"),
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableUserEmail() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_user_email",
+		Description: "Obtain information about the email addresses of a specific user within the GitLab instance (requires admin).",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("user_id"),
+			Hydrate:    listUserEmails,
+		},
+		Columns: userEmailColumns(),
+	}
+}
+
+// Hydrate Functions
+func listUserEmails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listUserEmails", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserEmails", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	userId := int(d.EqualsQuals["user_id"].GetInt64Value())
+	opt := &api.ListEmailsForUserOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listUserEmails", "userId", userId, "page", opt.Page, "perPage", opt.PerPage)
+		emails, resp, err := conn.Users.ListEmailsForUser(userId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserEmails", "userId", userId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain emails for user_id %d\n%v", userId, err)
+		}
+
+		for _, email := range emails {
+			d.StreamListItem(ctx, email)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listUserEmails", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listUserEmails", "completed successfully")
+	return nil, nil
+}
+
+// Column Functions
+func userEmailColumns() []*plugin.Column {
+	return append(emailColumns(), &plugin.Column{
+		Name:        "user_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the user who owns the email address - link to `gitlab_user.id`.",
+		Transform:   transform.FromQual("user_id"),
+	})
+}
+
+func emailColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the email address.",
+		},
+		{
+			Name:        "email",
+			Type:        proto.ColumnType_STRING,
+			Description: "The email address.",
+		},
+		{
+			Name:        "confirmed_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the email address was confirmed (null if unconfirmed).",
+		},
+	}
+}
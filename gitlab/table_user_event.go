@@ -17,27 +17,12 @@ func tableUserEvents() *plugin.Table {
 		Name:        "gitlab_user_event",
 		Description: "Obtain information about a user's events.",
 		List: &plugin.ListConfig{
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{
 					Name:    "author_id",
 					Require: plugin.Required,
 				},
-				{
-					Name:      "created_at",
-					Require:   plugin.Optional,
-					Operators: []string{">", ">=", "=", "<", "<="},
-				},
-				{
-					Name:      "target_type",
-					Require:   plugin.Optional,
-					Operators: []string{"="},
-				},
-				{
-					Name:      "action_name",
-					Require:   plugin.Optional,
-					Operators: []string{"="},
-				},
-			},
+			}, eventKeyColumns()...),
 			Hydrate: listUserEvents,
 		},
 		Columns: eventColumns(),
@@ -59,6 +44,62 @@ func listUserEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 		PerPage: 50,
 	}}
 
+	addOptionalEventQualifiers(opt, d)
+
+	for {
+		plugin.Logger(ctx).Debug("listUserEvents", "userID", userID, "page", opt.Page, "perPage", opt.PerPage)
+		events, resp, err := conn.Users.ListUserContributionEvents(userID, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserEvents", "userID", userID, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain events for user_id %d\n%v", userID, err)
+		}
+
+		for _, event := range events {
+			plugin.Logger(ctx).Debug("listMyEvents", "event", event)
+			d.StreamListItem(ctx, event)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listUserEvents", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listUserEvents", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// eventKeyColumns are the optional qualifiers supported by all of the event tables.
+func eventKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:      "created_at",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+		{
+			Name:      "target_type",
+			Require:   plugin.Optional,
+			Operators: []string{"="},
+		},
+		{
+			Name:      "action_name",
+			Require:   plugin.Optional,
+			Operators: []string{"="},
+		},
+	}
+}
+
+// addOptionalEventQualifiers translates the qualifiers from eventKeyColumns into the API options.
+func addOptionalEventQualifiers(opt *api.ListContributionEventsOptions, d *plugin.QueryData) {
 	if d.Quals["created_at"] != nil {
 		for _, q := range d.Quals["created_at"].Quals {
 			givenTime := q.Value.GetTimestampValue().AsTime()
@@ -95,34 +136,6 @@ func listUserEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 			strings.ToLower(d.EqualsQuals["action_name"].GetStringValue()))
 		opt.Action = &action
 	}
-
-	for {
-		plugin.Logger(ctx).Debug("listUserEvents", "userID", userID, "page", opt.Page, "perPage", opt.PerPage)
-		events, resp, err := conn.Users.ListUserContributionEvents(userID, opt)
-		if err != nil {
-			plugin.Logger(ctx).Error("listUserEvents", "userID", userID, "page", opt.Page, "error", err)
-			return nil, fmt.Errorf("unable to obtain events for user_id %d\n%v", userID, err)
-		}
-
-		for _, event := range events {
-			plugin.Logger(ctx).Debug("listMyEvents", "event", event)
-			d.StreamListItem(ctx, event)
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				plugin.Logger(ctx).Debug("listUserEvents", "completed successfully")
-				return nil, nil
-			}
-		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-
-		opt.Page = resp.NextPage
-	}
-
-	plugin.Logger(ctx).Debug("listUserEvents", "completed successfully")
-	return nil, nil
 }
 
 // Column Function
@@ -0,0 +1,223 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+type UserGPGKey struct {
+	ID          int
+	Key         string
+	KeyID       string
+	Fingerprint string
+	Algorithm   string
+	KeyBits     int
+	KeyCreated  *time.Time
+	ExpiresAt   *time.Time
+	CreatedAt   *time.Time
+}
+
+func tableUserGPGKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_user_gpg_key",
+		Description: "Obtain information about the GPG keys of a specific user within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("user_id"),
+			Hydrate:    listUserGPGKeys,
+		},
+		Columns: userGPGKeyColumns(),
+	}
+}
+
+// Hydrate Functions
+func listUserGPGKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listUserGPGKeys", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserGPGKeys", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	userId := int(d.EqualsQuals["user_id"].GetInt64Value())
+	plugin.Logger(ctx).Debug("listUserGPGKeys", "userId", userId)
+
+	keys, _, err := conn.Users.ListGPGKeysForUser(userId)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserGPGKeys", "userId", userId, "error", err)
+		return nil, fmt.Errorf("unable to obtain gpg keys for user_id %d\n%v", userId, err)
+	}
+
+	for _, key := range keys {
+		d.StreamListItem(ctx, parseGPGKey(ctx, key))
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	plugin.Logger(ctx).Debug("listUserGPGKeys", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// parseGPGKey converts an SDK GPGKey into a UserGPGKey, deriving the key ID, fingerprint, algorithm, size & expiry from
+// the primary key of the armored key and its self-signature.
+func parseGPGKey(ctx context.Context, key *api.GPGKey) *UserGPGKey {
+	output := &UserGPGKey{
+		ID:        key.ID,
+		Key:       key.Key,
+		CreatedAt: key.CreatedAt,
+	}
+
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key.Key))
+	if err != nil || len(entities) == 0 {
+		plugin.Logger(ctx).Warn("parseGPGKey", "id", key.ID, "unable to read armored key", err)
+		return output
+	}
+
+	entity := entities[0]
+	primary := entity.PrimaryKey
+	created := primary.CreationTime.UTC()
+	output.Fingerprint = strings.ToUpper(hex.EncodeToString(primary.Fingerprint))
+	output.KeyID = fmt.Sprintf("%016X", primary.KeyId)
+	output.KeyCreated = &created
+	output.Algorithm, output.KeyBits = gpgKeyAlgorithm(primary)
+
+	if sig, _ := entity.PrimarySelfSignature(); sig != nil && sig.KeyLifetimeSecs != nil && *sig.KeyLifetimeSecs > 0 {
+		expires := created.Add(time.Duration(*sig.KeyLifetimeSecs) * time.Second)
+		output.ExpiresAt = &expires
+	}
+
+	return output
+}
+
+// gpgCurves maps the curves of elliptic curve keys to the names used by GnuPG and their size in bits.
+var gpgCurves = map[packet.Curve]struct {
+	Name string
+	Bits int
+}{
+	packet.CurveNistP256:      {"nistp256", 256},
+	packet.CurveNistP384:      {"nistp384", 384},
+	packet.CurveNistP521:      {"nistp521", 521},
+	packet.CurveSecP256k1:     {"secp256k1", 256},
+	packet.CurveBrainpoolP256: {"brainpoolP256r1", 256},
+	packet.CurveBrainpoolP384: {"brainpoolP384r1", 384},
+	packet.CurveBrainpoolP512: {"brainpoolP512r1", 512},
+	packet.Curve25519:         {"25519", 256},
+	packet.Curve448:           {"448", 448},
+}
+
+// gpgKeyAlgorithm returns a descriptive name and size in bits for the algorithm of a public key.
+func gpgKeyAlgorithm(key *packet.PublicKey) (string, int) {
+	var algorithm string
+	switch key.PubKeyAlgo {
+	case packet.PubKeyAlgoRSA, packet.PubKeyAlgoRSAEncryptOnly, packet.PubKeyAlgoRSASignOnly:
+		algorithm = "rsa"
+	case packet.PubKeyAlgoElGamal:
+		algorithm = "elgamal"
+	case packet.PubKeyAlgoDSA:
+		algorithm = "dsa"
+	case packet.PubKeyAlgoECDH, packet.PubKeyAlgoX25519, packet.PubKeyAlgoX448:
+		algorithm = "ecdh"
+	case packet.PubKeyAlgoECDSA:
+		algorithm = "ecdsa"
+	case packet.PubKeyAlgoEdDSA, packet.PubKeyAlgoEd25519, packet.PubKeyAlgoEd448:
+		algorithm = "eddsa"
+	default:
+		return fmt.Sprintf("unknown (%d)", key.PubKeyAlgo), 0
+	}
+
+	// The size of elliptic curve keys is that of the curve, rather than of the encoded point.
+	if curve, err := key.Curve(); err == nil {
+		info, ok := gpgCurves[curve]
+		if !ok {
+			return algorithm, 0
+		}
+		name := info.Name
+		if curve == packet.Curve25519 || curve == packet.Curve448 {
+			// GnuPG names the curves after their use, e.g. ed25519 for signing & cv25519 for encryption.
+			name = map[string]string{"eddsa": "ed", "ecdh": "cv"}[algorithm] + name
+		}
+		return algorithm + " " + name, info.Bits
+	}
+
+	bits, err := key.BitLength()
+	if err != nil {
+		return algorithm, 0
+	}
+
+	return algorithm, int(bits)
+}
+
+// Column Functions
+func userGPGKeyColumns() []*plugin.Column {
+	return append(gpgKeyColumns(), &plugin.Column{
+		Name:        "user_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the user who owns the key - link to `gitlab_user.id`.",
+		Transform:   transform.FromQual("user_id"),
+	})
+}
+
+func gpgKeyColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the GPG key.",
+		},
+		{
+			Name:        "key",
+			Type:        proto.ColumnType_STRING,
+			Description: "The armored public GPG key.",
+		},
+		{
+			Name:        "key_id",
+			Type:        proto.ColumnType_STRING,
+			Description: "The long (16 character) key ID of the primary key.",
+			Transform:   transform.FromField("KeyID"),
+		},
+		{
+			Name:        "fingerprint",
+			Type:        proto.ColumnType_STRING,
+			Description: "The fingerprint of the primary key.",
+		},
+		{
+			Name:        "algorithm",
+			Type:        proto.ColumnType_STRING,
+			Description: "The public key algorithm of the primary key (rsa, dsa, eddsa ed25519, etc).",
+		},
+		{
+			Name:        "key_bits",
+			Type:        proto.ColumnType_INT,
+			Description: "The size of the primary key in bits.",
+		},
+		{
+			Name:        "key_created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the primary key was generated.",
+			Transform:   transform.FromField("KeyCreated"),
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the primary key expires, taken from its most recent self-signature (null if it doesn't expire).",
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the GPG key was added.",
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/xanzy/go-gitlab"
+)
+
+// The keys were generated with GnuPG, the expected values are those reported by `gpg --list-keys --with-colons`.
+const (
+	testGPGKeyRSA = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mQENBGrVgDcBCADV7uYVDsi6hYP7/bc+qiLK6KUaFvkNGrubPMVxhYIjYamU0bB6
+aaz9GXxzk1SHmECIe6hKiPK4pPo/u0HbrmxIzK599oMe/UZtH64IlTWi1mIgDrw5
+lZiXGa52nJWSmbD+5sLN4r8hSziKRhas/ktvRAWXWN+k8O5HBCaTlxJUJOjvDUh/
+TBegekuYfI8jcndPoFvNSd7xxVBGLnT7kiSoeystCZNWzdXKUDIJyXahqdX+nPOX
+Ap1cjrPSp7jYw1VKf4P1dbpmnRZCXeYVZS0R1Q70XPyZpATSEWgM+9qdOkeft0P1
+MTmcjO0ieLVQbtjeXXOXdmBhRFI5EUxSwfm/ABEBAAG0GlJTQSBUZXN0IDxyc2FA
+ZXhhbXBsZS5jb20+iQFUBBMBCgA+FiEEbAJddPdogrQmG5b6EfCrNbMf5c4FAmrV
+gDcCGwMFCQYHAQkFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQEfCrNbMf5c6z
+gQf/Q99y/zLahOTC7vi8DsLx9JQqTslPfFjomxTYMk2y8yh0XjTsYhUdwzPSDD6d
++4LWn9IAJYnIbmQTyR5jctRGopp4DKRA4pg7Z+aDM2g0PiFAY1OTZCQb46bp0fSc
+qgXSevgsiq22b0BCTUovLLq84pH9uuNWIjUe/7CmVfEkojHArDCa+lfNBBuOwN3T
+Jt5l0ZGDk8eB/RiZ+QWCdnBytMDG4DAiUymJI8FCWuZLO3b+P3i2AnoImdYSXCKU
+OvdVx9WTzYSUTKeyWoMvkoVl/U1Wc7DZ8wGVCEca4FGSgSQK0JTS8ZZfbS2PL7O9
+2c1IolLf/lZHvyWVV8+QjZEQ2Ih1BBATCAAdFiEEiAoNtjkq5t0wNpFOeR6/1lBR
+H/oFAmrVgD8ACgkQeR6/1lBRH/pmvgD+MBj0pm7ylJD9OONFSd5fcmPymVBZCSbH
+m7bz2sSz/McBALOnbKpNnYFq4QoIF2BP4AboxVjSd7SVmWvcyT72dHX5
+=H+Zn
+-----END PGP PUBLIC KEY BLOCK-----`
+
+	testGPGKeyECDSA = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mFIEatWANxMIKoZIzj0DAQcCAwROQLzOAJa6ChhdU0NyTKtLAvfbxYfrWPonNCPZ
+fsMXJSZrQpF4YcirBKm3wvBUTJR4h4zi+iAkDhMrXaQkdZvXtBpFQ0MgVGVzdCA8
+ZWNjQGV4YW1wbGUuY29tPoiQBBMTCAA4FiEEiAoNtjkq5t0wNpFOeR6/1lBRH/oF
+AmrVgDcCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQeR6/1lBRH/oCfgD/
+QxKMNOQaGH6GsPIi0eYDsF6wp7Kjjm12Pa67UhaLIX4BALhMdgQShkESGRDs+6kp
+1Z4JqRxt6xYihmSwz0LCSqwS
+=hTKP
+-----END PGP PUBLIC KEY BLOCK-----`
+
+	testGPGKeyEd25519 = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatWANxYJKwYBBAHaRw8BAQdAP8g9mtcCHS9cXXSvRF+YIo/f7ydoaTUfQdMz
+bFT3Wbi0GEVkIFRlc3QgPGVkQGV4YW1wbGUuY29tPoiWBBMWCAA+FiEEKHW3cddM
+kiw62F4/A3lES5b92ygFAmrVgDcCGwMFCQPCZwAFCwkIBwIGFQoJCAsCBBYCAwEC
+HgECF4AACgkQA3lES5b92yjUbQD/TJeTlwAozkojBGWtPUHmKdyXXs6ybxGiAOdu
+i8YXgM0BAJNzqAKtK99zo4t/ATrAR0RJ9jkxFwFguxpHuhhDtWgN
+=UJuf
+-----END PGP PUBLIC KEY BLOCK-----`
+
+	testGPGKeyEd25519NoExpiry = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatWANxYJKwYBBAHaRw8BAQdAP8g9mtcCHS9cXXSvRF+YIo/f7ydoaTUfQdMz
+bFT3Wbi0GEVkIFRlc3QgPGVkQGV4YW1wbGUuY29tPoiQBBMWCAA4AhsDBQsJCAcC
+BhUKCQgLAgQWAgMBAh4BAheAFiEEKHW3cddMkiw62F4/A3lES5b92ygFAmrVgD8A
+CgkQA3lES5b92ygmLgEAvnmiXaNjGNo9gdyc4IOe6Zavs/nKMdDBulOeopi8fGkB
+AMKjhv6ECEtIIeL0zITBxGP8v4c/dHvK8VVAK4LlJD4G
+=kkCt
+-----END PGP PUBLIC KEY BLOCK-----`
+)
+
+func TestParseGPGKey(t *testing.T) {
+	created := time.Unix(1792376887, 0).UTC()
+	tests := []struct {
+		name        string
+		key         string
+		fingerprint string
+		keyID       string
+		algorithm   string
+		keyBits     int
+		expiresAt   *time.Time
+	}{
+		{
+			// Includes a certification by the ECDSA key, which mustn't be taken as a self-signature.
+			name:        "rsa 2048 with expiry and third party certification",
+			key:         testGPGKeyRSA,
+			fingerprint: "6C025D74F76882B4261B96FA11F0AB35B31FE5CE",
+			keyID:       "11F0AB35B31FE5CE",
+			algorithm:   "rsa",
+			keyBits:     2048,
+			expiresAt:   testTime(1893499200),
+		},
+		{
+			name:        "ecdsa nistp256 without expiry",
+			key:         testGPGKeyECDSA,
+			fingerprint: "880A0DB6392AE6DD3036914E791EBFD650511FFA",
+			keyID:       "791EBFD650511FFA",
+			algorithm:   "ecdsa nistp256",
+			keyBits:     256,
+		},
+		{
+			name:        "eddsa ed25519 with expiry",
+			key:         testGPGKeyEd25519,
+			fingerprint: "2875B771D74C922C3AD85E3F0379444B96FDDB28",
+			keyID:       "0379444B96FDDB28",
+			algorithm:   "eddsa ed25519",
+			keyBits:     256,
+			expiresAt:   testTime(1855448887),
+		},
+		{
+			name:        "eddsa ed25519 with expiry removed",
+			key:         testGPGKeyEd25519NoExpiry,
+			fingerprint: "2875B771D74C922C3AD85E3F0379444B96FDDB28",
+			keyID:       "0379444B96FDDB28",
+			algorithm:   "eddsa ed25519",
+			keyBits:     256,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseGPGKey(testContext(), &api.GPGKey{ID: 1, Key: tt.key})
+			if got.Fingerprint != tt.fingerprint {
+				t.Errorf("fingerprint = %s, want %s", got.Fingerprint, tt.fingerprint)
+			}
+			if got.KeyID != tt.keyID {
+				t.Errorf("key id = %s, want %s", got.KeyID, tt.keyID)
+			}
+			if got.Algorithm != tt.algorithm {
+				t.Errorf("algorithm = %s, want %s", got.Algorithm, tt.algorithm)
+			}
+			if got.KeyBits != tt.keyBits {
+				t.Errorf("key bits = %d, want %d", got.KeyBits, tt.keyBits)
+			}
+			if got.KeyCreated == nil || !got.KeyCreated.Equal(created) {
+				t.Errorf("key created = %v, want %v", got.KeyCreated, created)
+			}
+			if !equalTimes(got.ExpiresAt, tt.expiresAt) {
+				t.Errorf("expires at = %v, want %v", got.ExpiresAt, tt.expiresAt)
+			}
+		})
+	}
+}
+
+func TestParseGPGKeyInvalid(t *testing.T) {
+	for _, key := range []string{"", "not a key", "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nAAAA\n-----END PGP PUBLIC KEY BLOCK-----"} {
+		got := parseGPGKey(testContext(), &api.GPGKey{ID: 1, Key: key})
+		if got.ID != 1 || got.Key != key {
+			t.Errorf("expected the id & key to be retained for %q", key)
+		}
+		if got.Fingerprint != "" || got.KeyCreated != nil || got.ExpiresAt != nil {
+			t.Errorf("expected no derived values for %q, got %+v", key, got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+)
+
+func tableUserMembership() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_user_membership",
+		Description: "Obtain information about the direct group and project memberships of a specific user within the GitLab instance (requires admin).",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "source_type",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+			Hydrate: listUserMemberships,
+		},
+		Columns: userMembershipColumns(),
+	}
+}
+
+// Hydrate Functions
+func listUserMemberships(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listUserMemberships", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserMemberships", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	q := d.EqualsQuals
+	userId := int(q["user_id"].GetInt64Value())
+	opt := &api.GetUserMembershipOptions{ListOptions: api.ListOptions{
+		Page:    1,
+		PerPage: 50,
+	}}
+
+	if q["source_type"] != nil {
+		sourceType := q["source_type"].GetStringValue()
+		opt.Type = &sourceType
+		plugin.Logger(ctx).Debug("listUserMemberships", "filter[source_type]", sourceType)
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listUserMemberships", "userId", userId, "page", opt.Page, "perPage", opt.PerPage)
+		memberships, resp, err := conn.Users.GetUserMemberships(userId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserMemberships", "userId", userId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain memberships for user_id %d\n%v", userId, err)
+		}
+
+		for _, membership := range memberships {
+			d.StreamListItem(ctx, membership)
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listUserMemberships", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listUserMemberships", "completed successfully")
+	return nil, nil
+}
+
+// Column Function
+func userMembershipColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "source_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the group or project the user is a member of.",
+		},
+		{
+			Name:        "source_name",
+			Type:        proto.ColumnType_STRING,
+			Description: "The name of the group or project the user is a member of.",
+		},
+		{
+			Name:        "source_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The type of the membership - Namespace (group) or Project.",
+		},
+		{
+			Name:        "access_level",
+			Type:        proto.ColumnType_INT,
+			Description: "The access level the user holds within the group or project.",
+			Transform:   transform.FromGo(),
+		},
+		{
+			Name:        "access_desc",
+			Type:        proto.ColumnType_STRING,
+			Description: "The descriptive of the access level held by the user.",
+			Transform:   transform.FromField("AccessLevel").Transform(accessLevelTransform),
+		},
+		{
+			Name:        "user_id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the user - link to `gitlab_user.id`.",
+			Transform:   transform.FromQual("user_id"),
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package gitlab
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	api "github.com/xanzy/go-gitlab"
+	"golang.org/x/crypto/ssh"
+)
+
+type UserSSHKey struct {
+	ID             int
+	Title          string
+	Key            string
+	KeyType        string
+	KeyBits        int
+	Fingerprint    string
+	FingerprintMD5 string
+	CreatedAt      *time.Time
+	ExpiresAt      *time.Time
+}
+
+func tableUserSSHKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "gitlab_user_ssh_key",
+		Description: "Obtain information about the SSH keys of a specific user within the GitLab instance.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("user_id"),
+			Hydrate:    listUserSSHKeys,
+		},
+		Columns: userSSHKeyColumns(),
+	}
+}
+
+// Hydrate Functions
+func listUserSSHKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Debug("listUserSSHKeys", "started")
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserSSHKeys", "unable to establish a connection", err)
+		return nil, fmt.Errorf("unable to establish a connection: %v", err)
+	}
+
+	userId := int(d.EqualsQuals["user_id"].GetInt64Value())
+	opt := &api.ListSSHKeysForUserOptions{
+		Page:    1,
+		PerPage: 50,
+	}
+
+	for {
+		plugin.Logger(ctx).Debug("listUserSSHKeys", "userId", userId, "page", opt.Page, "perPage", opt.PerPage)
+		keys, resp, err := conn.Users.ListSSHKeysForUser(userId, opt)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserSSHKeys", "userId", userId, "page", opt.Page, "error", err)
+			return nil, fmt.Errorf("unable to obtain ssh keys for user_id %d\n%v", userId, err)
+		}
+
+		for _, key := range keys {
+			d.StreamListItem(ctx, parseSSHKey(ctx, key))
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				plugin.Logger(ctx).Debug("listUserSSHKeys", "completed successfully")
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	plugin.Logger(ctx).Debug("listUserSSHKeys", "completed successfully")
+	return nil, nil
+}
+
+// Assist Functions
+
+// parseSSHKey converts an SDK SSHKey into a UserSSHKey, deriving the type, size & fingerprints from the public key.
+func parseSSHKey(ctx context.Context, key *api.SSHKey) *UserSSHKey {
+	output := &UserSSHKey{
+		ID:        key.ID,
+		Title:     key.Title,
+		Key:       key.Key,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.Key))
+	if err != nil {
+		plugin.Logger(ctx).Warn("parseSSHKey", "id", key.ID, "unable to parse public key", err)
+		return output
+	}
+
+	output.KeyType = pub.Type()
+	output.Fingerprint = ssh.FingerprintSHA256(pub)
+	output.FingerprintMD5 = ssh.FingerprintLegacyMD5(pub)
+
+	if cpk, ok := pub.(ssh.CryptoPublicKey); ok {
+		switch k := cpk.CryptoPublicKey().(type) {
+		case *rsa.PublicKey:
+			output.KeyBits = k.N.BitLen()
+		case *ecdsa.PublicKey:
+			output.KeyBits = k.Curve.Params().BitSize
+		}
+	}
+
+	switch pub.Type() {
+	case ssh.KeyAlgoED25519, ssh.KeyAlgoSKED25519:
+		output.KeyBits = 256
+	case ssh.KeyAlgoSKECDSA256:
+		output.KeyBits = 256
+	case ssh.KeyAlgoDSA:
+		output.KeyBits = 1024
+	}
+
+	return output
+}
+
+// Column Functions
+func userSSHKeyColumns() []*plugin.Column {
+	return append(sshKeyColumns(), &plugin.Column{
+		Name:        "user_id",
+		Type:        proto.ColumnType_INT,
+		Description: "The ID of the user who owns the key - link to `gitlab_user.id`.",
+		Transform:   transform.FromQual("user_id"),
+	})
+}
+
+func sshKeyColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "id",
+			Type:        proto.ColumnType_INT,
+			Description: "The ID of the SSH key.",
+		},
+		{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "The title of the SSH key.",
+		},
+		{
+			Name:        "key",
+			Type:        proto.ColumnType_STRING,
+			Description: "The public SSH key.",
+		},
+		{
+			Name:        "key_type",
+			Type:        proto.ColumnType_STRING,
+			Description: "The algorithm of the SSH key (ssh-rsa, ssh-ed25519, ecdsa-sha2-nistp256, etc).",
+		},
+		{
+			Name:        "key_bits",
+			Type:        proto.ColumnType_INT,
+			Description: "The size of the SSH key in bits.",
+		},
+		{
+			Name:        "fingerprint",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA256 fingerprint of the SSH key.",
+		},
+		{
+			Name:        "fingerprint_md5",
+			Type:        proto.ColumnType_STRING,
+			Description: "The legacy MD5 fingerprint of the SSH key.",
+			Transform:   transform.FromField("FingerprintMD5"),
+		},
+		{
+			Name:        "created_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the SSH key was added.",
+		},
+		{
+			Name:        "expires_at",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Description: "Timestamp of when the SSH key expires.",
+		},
+	}
+}
@@ -2,11 +2,21 @@ package gitlab
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math"
+	"net/http"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	api "github.com/xanzy/go-gitlab"
@@ -14,6 +24,42 @@ import (
 
 const publicGitLabBaseUrl = "https://gitlab.com/api/v4"
 
+const (
+	variableValueModePlaintext = "plaintext"
+	variableValueModeRedacted  = "redacted"
+	variableValueModeSHA256    = "sha256"
+	variableValueModeOmit      = "omit"
+)
+
+type CIVariable struct {
+	Key              string
+	Value            *string
+	ValueLength      int
+	LooksLikeSecret  bool
+	VariableType     api.VariableTypeValue
+	EnvironmentScope string
+	Protected        bool
+	Masked           bool
+	Raw              bool
+}
+
+// secretValuePatterns match the formats of well known tokens, keys & credentials.
+var secretValuePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^gl[a-z]{2,6}-[0-9A-Za-z_\-]{20,}`),
+	regexp.MustCompile(`^gh[pousr]_[0-9A-Za-z]{36,}`),
+	regexp.MustCompile(`^github_pat_[0-9A-Za-z_]{22,}`),
+	regexp.MustCompile(`^xox[abposr]-[0-9A-Za-z\-]{10,}`),
+	regexp.MustCompile(`^(AKIA|ASIA)[0-9A-Z]{16}$`),
+	regexp.MustCompile(`^AIza[0-9A-Za-z_\-]{35}$`),
+	regexp.MustCompile(`^[rs]k_(live|test)_[0-9A-Za-z]{16,}`),
+	regexp.MustCompile(`^eyJ[0-9A-Za-z_\-]+\.eyJ[0-9A-Za-z_\-]+\.`),
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`),
+}
+
+// secretKeyPattern matches variable keys which by convention hold secrets.
+var secretKeyPattern = regexp.MustCompile(`(?i)(TOKEN|SECRET|PASSW(OR)?D|PASSPHRASE|PRIVATE_?KEY|API_?KEY|ACCESS_?KEY|CREDENTIAL)`)
+
 func connect(ctx context.Context, d *plugin.QueryData) (*api.Client, error) {
 	cacheKey := "gitlab"
 	if cachedData, ok := d.ConnectionCache.Get(ctx, cacheKey); ok {
@@ -73,22 +119,11 @@ func accessLevelTransform(_ context.Context, input *transform.TransformData) (in
 		return nil, nil
 	}
 
-	x := *input.Value.(*api.AccessLevelValue)
-	switch x {
-	case api.NoPermissions:
-		return "No Permissions", nil
-	case api.MinimalAccessPermissions:
-		return "Minimal Access", nil
-	case api.GuestPermissions:
-		return "Guest", nil
-	case api.ReporterPermissions:
-		return "Reporter", nil
-	case api.DeveloperPermissions:
-		return "Developer", nil
-	case api.MaintainerPermissions:
-		return "Maintainer", nil
-	case api.OwnerPermissions:
-		return "Owner", nil
+	switch x := input.Value.(type) {
+	case *api.AccessLevelValue:
+		return parseAccessLevel(int(*x)), nil
+	case api.AccessLevelValue:
+		return parseAccessLevel(int(x)), nil
 	default:
 		return "No Permissions", nil
 	}
@@ -127,3 +162,242 @@ func isPublicGitLab(d *plugin.QueryData) bool {
 
 	return false
 }
+
+// variableValueMode is a util function returning the configured handling of CI/CD variable values, defaulting to plaintext
+func variableValueMode(d *plugin.QueryData) (string, error) {
+	cfg := GetConfig(d.Connection)
+	if cfg.VariableValueMode == nil || *cfg.VariableValueMode == "" {
+		return variableValueModePlaintext, nil
+	}
+
+	mode := strings.ToLower(*cfg.VariableValueMode)
+	switch mode {
+	case variableValueModePlaintext, variableValueModeRedacted, variableValueModeSHA256, variableValueModeOmit:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid variable_value_mode '%s' - must be one of plaintext, redacted, sha256 or omit", *cfg.VariableValueMode)
+	}
+}
+
+// parseCIVariable converts a project, group or instance variable into a CIVariable, applying the variable_value_mode to
+// the value while deriving the length & secret heuristic from the original value.
+func parseCIVariable(mode string, variable interface{}) *CIVariable {
+	var output *CIVariable
+	switch v := variable.(type) {
+	case *api.ProjectVariable:
+		output = &CIVariable{Key: v.Key, Value: &v.Value, VariableType: v.VariableType, EnvironmentScope: v.EnvironmentScope, Protected: v.Protected, Masked: v.Masked, Raw: v.Raw}
+	case *api.GroupVariable:
+		output = &CIVariable{Key: v.Key, Value: &v.Value, VariableType: v.VariableType, EnvironmentScope: v.EnvironmentScope, Protected: v.Protected, Masked: v.Masked, Raw: v.Raw}
+	case *api.InstanceVariable:
+		output = &CIVariable{Key: v.Key, Value: &v.Value, VariableType: v.VariableType, Protected: v.Protected, Masked: v.Masked, Raw: v.Raw}
+	default:
+		return nil
+	}
+
+	value := *output.Value
+	output.ValueLength = utf8.RuneCountInString(value)
+	output.LooksLikeSecret = looksLikeSecret(output.Key, value)
+
+	switch mode {
+	case variableValueModeRedacted:
+		redacted := ""
+		if value != "" {
+			redacted = "REDACTED"
+		}
+		output.Value = &redacted
+	case variableValueModeSHA256:
+		// An unsalted hash is a fingerprint for comparing values rather than a redaction, as short values can be brute-forced.
+		hash := sha256.Sum256([]byte(value))
+		hashed := hex.EncodeToString(hash[:])
+		output.Value = &hashed
+	case variableValueModeOmit:
+		output.Value = nil
+	}
+
+	return output
+}
+
+// looksLikeSecret is a heuristic indicating if a variable holds a secret based upon its key, the format of its value
+// or the randomness (shannon entropy) of its value.
+func looksLikeSecret(key string, value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for _, p := range secretValuePatterns {
+		if p.MatchString(value) {
+			return true
+		}
+	}
+
+	if secretKeyPattern.MatchString(key) {
+		return true
+	}
+
+	if utf8.RuneCountInString(value) < 20 || strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+
+	counts := make(map[rune]float64)
+	for _, r := range value {
+		counts[r]++
+	}
+
+	entropy := 0.0
+	length := float64(utf8.RuneCountInString(value))
+	for _, c := range counts {
+		p := c / length
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy >= 4.0
+}
+
+// isColumnRequested is a util function to determine if any of the given columns have been selected in the query
+func isColumnRequested(d *plugin.QueryData, columns ...string) bool {
+	for _, selected := range d.QueryContext.Columns {
+		for _, column := range columns {
+			if selected == column {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// graphQLQuery is a util function for executing a query against the GraphQL API of the instance, for data not exposed by the REST API
+func graphQLQuery(conn *api.Client, query string, variables map[string]interface{}, data interface{}) error {
+	body := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}{
+		Query:     query,
+		Variables: variables,
+	}
+
+	req, err := conn.NewRequest(http.MethodPost, "", body, nil)
+	if err != nil {
+		return err
+	}
+
+	// The GraphQL API is served from /api/graphql rather than below the versioned REST API path.
+	req.URL.Path = strings.TrimSuffix(strings.TrimSuffix(conn.BaseURL().Path, "/"), "/v4") + "/graphql"
+	req.URL.RawPath = ""
+
+	resp := struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if _, err = conn.Do(req, &resp); err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		var messages []string
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("graphql query failed: %s", strings.Join(messages, "; "))
+	}
+
+	return json.Unmarshal(resp.Data, data)
+}
+
+// parseGlobalID is a util function for returning the numeric ID from a GraphQL global ID (e.g. gid://gitlab/User/1)
+func parseGlobalID(gid string) int {
+	id, err := strconv.Atoi(gid[strings.LastIndex(gid, "/")+1:])
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
+
+// timeRangeQualifiers is a util function translating the qualifiers of a timestamp column into inclusive after/before bounds
+// (strict operators are widened to their inclusive equivalent, as the qualifiers are re-applied to the returned rows).
+func timeRangeQualifiers(d *plugin.QueryData, column string) (*time.Time, *time.Time) {
+	var after, before *time.Time
+	if d.Quals[column] == nil {
+		return after, before
+	}
+
+	for _, q := range d.Quals[column].Quals {
+		givenTime := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">", ">=":
+			if after == nil || givenTime.After(*after) {
+				after = &givenTime
+			}
+		case "<", "<=":
+			if before == nil || givenTime.Before(*before) {
+				before = &givenTime
+			}
+		case "=":
+			// The API treats identical bounds as an empty range, so a window of a second either side is used.
+			windowStart := givenTime.Add(time.Duration(-1) * time.Second)
+			windowEnd := givenTime.Add(time.Second * 1)
+			after = &windowStart
+			before = &windowEnd
+		}
+	}
+
+	return after, before
+}
+
+// labelSetQualifiers is a util function translating the ?, ?& and ?| qualifiers of a labels column into the label sets to query,
+// the API only supports matching all labels so each label of a ?| qualifier requires its own query.
+func labelSetQualifiers(d *plugin.QueryData, column string) []*api.Labels {
+	var allOf, anyOf []string
+	if d.Quals[column] != nil {
+		for _, q := range d.Quals[column].Quals {
+			switch q.Operator {
+			case "?", "?&":
+				allOf = append(allOf, qualStringValues(q.Value)...)
+			case "?|":
+				anyOf = qualStringValues(q.Value)
+			}
+		}
+	}
+
+	if len(anyOf) == 0 {
+		if len(allOf) == 0 {
+			return []*api.Labels{nil}
+		}
+		labels := api.Labels(allOf)
+		return []*api.Labels{&labels}
+	}
+
+	var sets []*api.Labels
+	for _, label := range anyOf {
+		labels := append(api.Labels{label}, allOf...)
+		sets = append(sets, &labels)
+	}
+
+	return sets
+}
+
+// qualStringValues is a util function returning the string values of a qualifier which may be a single value or a list
+func qualStringValues(value *proto.QualValue) []string {
+	if list := value.GetListValue(); list != nil {
+		var values []string
+		for _, v := range list.Values {
+			values = append(values, v.GetStringValue())
+		}
+		return values
+	}
+
+	return []string{value.GetStringValue()}
+}
+
+// withQueryParam is a util function returning a request option which adds a query parameter not supported by the SDK options
+func withQueryParam(key, value string) api.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set(key, value)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+package gitlab
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testContext returns a context carrying the logger expected by plugin.Logger.
+func testContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+// testQueryData returns query data holding the given qualifiers, as the SDK populates it for a hydrate call.
+func testQueryData(qs ...*quals.Qual) *plugin.QueryData {
+	qualMap := plugin.KeyColumnQualMap{}
+	for _, q := range qs {
+		if qualMap[q.Column] == nil {
+			qualMap[q.Column] = &plugin.KeyColumnQuals{Name: q.Column}
+		}
+		qualMap[q.Column].Quals = append(qualMap[q.Column].Quals, q)
+	}
+
+	return &plugin.QueryData{Quals: qualMap, EqualsQuals: qualMap.ToEqualsQualValueMap()}
+}
+
+// testQual returns a qualifier of a string, bool, int or timestamp column.
+func testQual(column string, operator string, value interface{}) *quals.Qual {
+	qual := &quals.Qual{Column: column, Operator: operator}
+	switch v := value.(type) {
+	case string:
+		qual.Value = &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: v}}
+	case bool:
+		qual.Value = &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: v}}
+	case int:
+		qual.Value = &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: int64(v)}}
+	case time.Time:
+		qual.Value = &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(v)}}
+	}
+
+	return qual
+}
+
+func testTime(unix int64) *time.Time {
+	t := time.Unix(unix, 0).UTC()
+	return &t
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}